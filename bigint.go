@@ -0,0 +1,168 @@
+package null
+
+import (
+	"database/sql/driver"
+	"math/big"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// BigInt is a nullable big.Int, for integers that do not fit an Int or Uint64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// BigInt copies the big.Int it is created from, and values are never
+// modified in place, so a BigInt can be copied like the other types.
+type BigInt struct {
+	BigInt *big.Int
+	Valid  bool // Valid is true if BigInt is not NULL
+}
+
+// NewBigInt creates a new BigInt holding a copy of i.
+// A nil i is considered 0.
+func NewBigInt(i *big.Int, valid bool) BigInt {
+	n := new(big.Int)
+	if i != nil {
+		n.Set(i)
+	}
+	return BigInt{
+		BigInt: n,
+		Valid:  valid,
+	}
+}
+
+// BigIntFrom creates a new BigInt that will always be valid.
+func BigIntFrom(i *big.Int) BigInt {
+	return NewBigInt(i, true)
+}
+
+// BigIntFromPtr creates a new BigInt that will be null if i is nil.
+func BigIntFromPtr(i *big.Int) BigInt {
+	if i == nil {
+		return NewBigInt(nil, false)
+	}
+	return NewBigInt(i, true)
+}
+
+// ValueOrZero returns a copy of the inner value if valid, otherwise zero.
+func (i BigInt) ValueOrZero() *big.Int {
+	if !i.Valid || i.BigInt == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(i.BigInt)
+}
+
+// Scan implements the Scanner interface.
+// It accepts the string and []byte values drivers return for NUMERIC
+// columns, as well as integers and floats holding an integer.
+func (i *BigInt) Scan(value interface{}) error {
+	var err error
+	i.BigInt, i.Valid, err = scalar.ScanBigInt(value, reflect.TypeOf(*i))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// Values that fit an int64 are passed as one, and larger values
+// as their decimal string, which NUMERIC columns accept.
+func (i BigInt) Value() (driver.Value, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.BigIntDriverValue(i.ValueOrZero()), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null BigInt.
+func (i *BigInt) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *BigInt) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.BigInt, i.Valid, err = scalar.UnmarshalBigIntJSON(data, reflect.TypeOf(*i), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null BigInt if the input is blank or "null".
+// It will return an error if the input is not an integer, blank, or "null".
+func (i *BigInt) UnmarshalText(text []byte) error {
+	var err error
+	i.BigInt, i.Valid, err = scalar.UnmarshalBigIntText(text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigInt is null, and a JSON number otherwise.
+// Use BigIntString to encode a JSON string instead.
+func (i BigInt) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(i.ValueOrZero().String()), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this BigInt is null.
+func (i BigInt) MarshalText() ([]byte, error) {
+	if !i.Valid {
+		return []byte{}, nil
+	}
+	return []byte(i.ValueOrZero().String()), nil
+}
+
+// SetValid changes this BigInt's value to a copy of n and also sets it to be non-null.
+func (i *BigInt) SetValid(n *big.Int) {
+	*i = NewBigInt(n, true)
+}
+
+// Ptr returns a copy of this BigInt's value, or a nil pointer if this BigInt is null.
+func (i BigInt) Ptr() *big.Int {
+	if !i.Valid {
+		return nil
+	}
+	return i.ValueOrZero()
+}
+
+// IsZero returns true for invalid BigInts, for future omitempty support.
+// A non-null BigInt with a 0 value will not be considered zero.
+func (i BigInt) IsZero() bool {
+	return !i.Valid
+}
+
+// BigIntString is a nullable big.Int that is encoded as a JSON string,
+// such as "340282366920938463463374607431768211456", for clients that
+// cannot read large JSON numbers. It accepts both numbers and strings as input.
+// Everything else, including SQL and text, behaves like BigInt.
+type BigIntString struct {
+	BigInt
+}
+
+// BigIntStringFrom creates a new BigIntString that will always be valid.
+func BigIntStringFrom(i *big.Int) BigIntString {
+	return BigIntString{BigInt: BigIntFrom(i)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (i *BigIntString) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings are always accepted.
+func (i *BigIntString) UnmarshalJSONWith(data []byte, p Policy) error {
+	p.NumberStrings = true
+	return i.BigInt.UnmarshalJSONWith(data, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this BigIntString is null, and a quoted number otherwise.
+func (i BigIntString) MarshalJSON() ([]byte, error) {
+	if !i.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + i.ValueOrZero().String() + `"`), nil
+}
@@ -0,0 +1,109 @@
+package null
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+var bigIntJSON = []byte(`340282366920938463463374607431768211456`)
+
+func TestBigIntJSON(t *testing.T) {
+	var i BigInt
+	err := json.Unmarshal(bigIntJSON, &i)
+	maybePanic(err)
+	want := new(big.Int).Lsh(big.NewInt(1), 128)
+	if !i.Valid || i.BigInt.Cmp(want) != 0 {
+		t.Errorf("bad big int json: %v", i.BigInt)
+	}
+
+	data, err := json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(bigIntJSON), "big int json marshal")
+
+	data, err = json.Marshal(BigIntStringFrom(want))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"`+string(bigIntJSON)+`"`, "big int string json marshal")
+
+	err = json.Unmarshal([]byte(`"-12"`), &i)
+	maybePanic(err)
+	if i.BigInt.Int64() != -12 {
+		t.Errorf("bad big int string json: %v", i.BigInt)
+	}
+	if err = i.UnmarshalJSONWith([]byte(`"-12"`), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for big int string")
+	}
+	if err = json.Unmarshal(floatJSON, &i); err == nil {
+		t.Error("expected error for fractional json")
+	}
+
+	for in, want := range map[string]*big.Int{
+		"1e3":   big.NewInt(1000),
+		"1.0":   big.NewInt(1),
+		"-2E2":  big.NewInt(-200),
+		"1e400": new(big.Int).Exp(big.NewInt(10), big.NewInt(400), nil),
+	} {
+		err = json.Unmarshal([]byte(in), &i)
+		maybePanic(err)
+		if !i.Valid || i.BigInt.Cmp(want) != 0 {
+			t.Errorf("bad big int json %s: %v", in, i.BigInt)
+		}
+	}
+	for _, in := range []string{`1.5`, `1e-1`, `1e1000000000`, `"1/3"`, `"0x10"`} {
+		if err = json.Unmarshal([]byte(in), &i); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+	}
+
+	err = json.Unmarshal(nullJSON, &i)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(i)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null big int json marshal")
+}
+
+func TestBigIntSQL(t *testing.T) {
+	var i BigInt
+	err := i.Scan([]byte("340282366920938463463374607431768211456"))
+	maybePanic(err)
+	v, err := i.Value()
+	maybePanic(err)
+	if v != "340282366920938463463374607431768211456" {
+		t.Errorf("bad big int driver value: %#v", v)
+	}
+
+	err = i.Scan(int64(42))
+	maybePanic(err)
+	if v, _ = i.Value(); v != int64(42) {
+		t.Errorf("bad small big int driver value: %#v", v)
+	}
+
+	if err = i.Scan(1.5); err == nil {
+		t.Error("expected error for fractional float")
+	}
+
+	err = i.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	if i.Valid || i.Ptr() != nil || i.ValueOrZero().Sign() != 0 {
+		t.Error("null text", "is valid, but should be invalid")
+	}
+}
+
+func TestBigIntCopies(t *testing.T) {
+	n := big.NewInt(7)
+	i := BigIntFrom(n)
+	n.SetInt64(8)
+	if i.BigInt.Int64() != 7 {
+		t.Error("BigIntFrom should copy its argument")
+	}
+	i.Ptr().SetInt64(9)
+	if i.BigInt.Int64() != 7 {
+		t.Error("Ptr should return a copy")
+	}
+	if BigIntFromPtr(nil).Valid {
+		t.Error("BigIntFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
@@ -2,11 +2,9 @@ package null
 
 import (
 	"database/sql"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
 	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Bool is a nullable bool.
@@ -45,60 +43,32 @@ func (b Bool) ValueOrZero() bool {
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
-// 0 will not be considered a null Bool.
+// It supports bool and null input.
+// false will not be considered a null Bool.
 // It also supports unmarshalling a sql.NullBool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case bool:
-		b.Bool = x
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &b.NullBool)
-	case nil:
-		b.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Bool", reflect.TypeOf(v).Name())
-	}
-	b.Valid = err == nil
-	return err
-}
-
-func (t *Bool) SetBSON(raw bson.Raw) error {
-	return t.UnmarshalJSON(raw.Data)
+	return b.UnmarshalJSONWith(data, scalar.Default)
 }
 
-func (t Bool) GetBSON() (interface{}, error) {
-	if !t.Valid {
-		return nil, nil
-	}
-	return t.Bool, nil
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (b *Bool) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	b.Bool, b.Valid, err = scalar.UnmarshalJSONPolicy[bool](data, reflect.TypeOf(*b), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Bool if the input is a blank or not an integer.
 // It will return an error if the input is not an integer, blank, or "null".
 func (b *Bool) UnmarshalText(text []byte) error {
-	str := string(text)
-	switch str {
-	case "", "null":
-		b.Valid = false
-		return nil
-	case "true":
-		b.Bool = true
-	case "false":
-		b.Bool = false
-	default:
-		b.Valid = false
-		return errors.New("invalid input:" + str)
-	}
-	b.Valid = true
-	return nil
+	return b.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (b *Bool) UnmarshalTextWith(text []byte, p Policy) error {
+	var err error
+	b.Bool, b.Valid, err = scalar.UnmarshalTextPolicy[bool](text, p)
+	return err
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -107,10 +77,7 @@ func (b Bool) MarshalJSON() ([]byte, error) {
 	if !b.Valid {
 		return []byte("null"), nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return scalar.MarshalJSON(b.Bool)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -119,10 +86,7 @@ func (b Bool) MarshalText() ([]byte, error) {
 	if !b.Valid {
 		return []byte{}, nil
 	}
-	if !b.Bool {
-		return []byte("false"), nil
-	}
-	return []byte("true"), nil
+	return []byte(scalar.Format(b.Bool)), nil
 }
 
 // SetValid changes this Bool's value and also sets it to be non-null.
@@ -0,0 +1,44 @@
+//go:build mgo && mongo
+
+package null
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	mongobson "go.mongodb.org/mongo-driver/bson"
+)
+
+// Documents written by either library can be read by the other.
+func TestBSONCrossLibrary(t *testing.T) {
+	in := bsonDoc{
+		S:   StringFrom("test"),
+		I:   IntFrom(math.MinInt64),
+		F:   FloatFrom(1.2345),
+		B:   BoolFrom(true),
+		T:   TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+		I8:  Int8From(-8),
+		U64: Uint64From(math.MaxUint64),
+		F32: Float32From(1.5),
+		V:   ValueFrom[uint16](16),
+	}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+	var fromMgo bsonDoc
+	err = bson.Unmarshal(data, &fromMgo)
+	maybePanic(err)
+	if fromMgo != in {
+		t.Errorf("bad mgo decode of driver document: %+v ≠ %+v", fromMgo, in)
+	}
+
+	data, err = bson.Marshal(in)
+	maybePanic(err)
+	var fromDriver bsonDoc
+	err = mongobson.Unmarshal(data, &fromDriver)
+	maybePanic(err)
+	if fromDriver != in {
+		t.Errorf("bad driver decode of mgo document: %+v ≠ %+v", fromDriver, in)
+	}
+}
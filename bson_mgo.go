@@ -0,0 +1,532 @@
+//go:build mgo
+
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+	"github.com/globalsign/mgo/bson"
+)
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, int32, int64 and integral double and decimal128 values.
+func (i *BigInt) SetBSON(raw bson.Raw) error {
+	var err error
+	i.BigInt, i.Valid, err = scalar.DecodeBSONBigInt(raw.Kind, raw.Data, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this BigInt is null, an int64 if the value fits,
+// and a decimal128 otherwise.
+func (i BigInt) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONBigInt(i.ValueOrZero())
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (b *Bool) SetBSON(raw bson.Raw) error {
+	var err error
+	b.Bool, b.Valid, err = scalar.DecodeBSON[bool](raw, reflect.TypeOf(*b))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Bool is null.
+func (b Bool) GetBSON() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(b.Bool)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and binary values of any subtype.
+func (b *Bytes) SetBSON(raw bson.Raw) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.DecodeBSONBytes(raw.Kind, raw.Data, reflect.TypeOf(*b))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Bytes is null, and generic binary otherwise.
+func (b Bytes) GetBSON() (interface{}, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONBytes(b.Bytes)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, datetimes, read in UTC, and "2006-01-02" strings.
+func (d *Date) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Date, d.Valid, err = scalar.DecodeBSONDate(raw.Kind, raw.Data, reflect.TypeOf(*d))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Date is null, and a datetime at midnight UTC otherwise.
+func (d Date) GetBSON() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONDate(d.Date)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, decimal128, int32, int64 and double values.
+func (d *Decimal) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.DecodeBSONDecimal(raw.Kind, raw.Data, reflect.TypeOf(*d))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Decimal is null, and a decimal128 otherwise.
+func (d Decimal) GetBSON() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDecimal(d.Decimal)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, a number of nanoseconds, or a duration string.
+func (d *Duration) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.DecodeBSONDuration(raw.Kind, raw.Data, reflect.TypeOf(*d), time.Nanosecond)
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Duration is null, and a number of nanoseconds otherwise.
+func (d Duration) GetBSON() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration, time.Nanosecond)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, a number of seconds, or a duration string.
+func (d *DurationSeconds) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.DecodeBSONDuration(raw.Kind, raw.Data, reflect.TypeOf(*d), time.Second)
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this DurationSeconds is null, and a number of seconds otherwise.
+func (d DurationSeconds) GetBSON() (interface{}, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration.Duration, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float) SetBSON(raw bson.Raw) error {
+	var err error
+	f.Float64, f.Valid, err = scalar.DecodeBSON[float64](raw, reflect.TypeOf(*f))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Float is null.
+func (f Float) GetBSON() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(f.Float64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float32) SetBSON(raw bson.Raw) error {
+	var err error
+	f.Float32, f.Valid, err = scalar.DecodeBSON[float32](raw, reflect.TypeOf(*f))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Float32 is null.
+func (f Float32) GetBSON() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(f.Float32)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float64) SetBSON(raw bson.Raw) error {
+	var err error
+	f.Float64, f.Valid, err = scalar.DecodeBSON[float64](raw, reflect.TypeOf(*f))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Float64 is null.
+func (f Float64) GetBSON() (interface{}, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(f.Float64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Int64, i.Valid, err = scalar.DecodeBSON[int64](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int is null.
+func (i Int) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int8) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Int8, i.Valid, err = scalar.DecodeBSON[int8](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int8 is null.
+func (i Int8) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int8)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int16) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Int16, i.Valid, err = scalar.DecodeBSON[int16](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int16 is null.
+func (i Int16) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int16)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int32) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Int32, i.Valid, err = scalar.DecodeBSON[int32](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int32 is null.
+func (i Int32) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int32)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int64) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Int64, i.Valid, err = scalar.DecodeBSON[int64](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int64 is null.
+func (i Int64) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes any BSON value, so documents and arrays become JSON objects
+// and arrays. Datetimes, ObjectIDs and binary data become strings.
+func (j *JSON) SetBSON(raw bson.Raw) error {
+	var err error
+	j.JSON, j.Valid, err = scalar.DecodeBSONJSON(raw.Kind, raw.Data, reflect.TypeOf(*j))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this JSON is null. Objects are encoded as
+// subdocuments, arrays as arrays, and integers as int32 or int64.
+func (j JSON) GetBSON() (interface{}, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONJSON(j.JSON)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It supports BSON datetime and null values.
+// The time is converted to the Location of the format, if it has one.
+func (t *LayoutTime[L]) SetBSON(raw bson.Raw) error {
+	err := t.Time.SetBSON(raw)
+	t.Time.Time = t.Format().Normalize(t.Time.Time)
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this LayoutTime is null,
+// and the time at the Precision of its format otherwise.
+func (t LayoutTime[L]) GetBSON() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Format().Store(t.Time.Time), nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, and other values into V with mgo's codec.
+// If mgo cannot decode the value into V, it is decoded through
+// its JSON form instead, as GetBSON stores such values.
+func (o *Object[T]) SetBSON(raw bson.Raw) error {
+	if raw.Kind == scalar.BSONNull || raw.Kind == scalar.BSONUndefined {
+		return o.decode(nil, false)
+	}
+	var v T
+	err := raw.Unmarshal(&v)
+	if err == nil {
+		o.V, o.Valid = v, true
+		return nil
+	}
+	doc, _, jsonErr := scalar.DecodeBSONJSON(raw.Kind, raw.Data, reflect.TypeOf(*o))
+	if jsonErr != nil || o.decode(doc, true) != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Object is null, and V with mgo's codec
+// otherwise, so a struct is stored as a subdocument and a time.Time field
+// as a datetime. If mgo cannot encode V, V is stored through its
+// JSON form instead.
+func (o Object[T]) GetBSON() (interface{}, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	// mgo only marshals documents, so V is encoded as a field of one.
+	data, err := bson.Marshal(struct{ V T }{o.V})
+	if err == nil {
+		var doc struct{ V bson.Raw }
+		if err = bson.Unmarshal(data, &doc); err == nil {
+			return doc.V, nil
+		}
+	}
+	doc, jsonErr := json.Marshal(o.V)
+	if jsonErr != nil {
+		return nil, err
+	}
+	kind, data, jsonErr := scalar.EncodeBSONJSON(doc)
+	if jsonErr != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (s *String) SetBSON(raw bson.Raw) error {
+	var err error
+	s.String, s.Valid, err = scalar.DecodeBSON[string](raw, reflect.TypeOf(*s))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this String is null.
+func (s String) GetBSON() (interface{}, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(s.String)
+}
+
+// SetBSON implements bson.Setter.
+// It supports BSON datetime and null values.
+func (t *Time) SetBSON(raw bson.Raw) error {
+	var err error
+	t.Time, t.Valid, err = scalar.DecodeBSONTime(raw, reflect.TypeOf(*t))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Time is null.
+func (t Time) GetBSON() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and "15:04:05" strings.
+func (t *TimeOfDay) SetBSON(raw bson.Raw) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.DecodeBSONTimeOfDay(raw.Kind, raw.Data, reflect.TypeOf(*t))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this TimeOfDay is null, and a "15:04:05" string otherwise.
+func (t TimeOfDay) GetBSON() (interface{}, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONTimeOfDay(t.TimeOfDay)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint8) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Uint8, i.Valid, err = scalar.DecodeBSON[uint8](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint8 is null.
+func (i Uint8) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Uint8)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint16) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Uint16, i.Valid, err = scalar.DecodeBSON[uint16](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint16 is null.
+func (i Uint16) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Uint16)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint32) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Uint32, i.Valid, err = scalar.DecodeBSON[uint32](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint32 is null.
+func (i Uint32) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Uint32)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint64) SetBSON(raw bson.Raw) error {
+	var err error
+	i.Uint64, i.Valid, err = scalar.DecodeBSON[uint64](raw, reflect.TypeOf(*i))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint64 is null.
+func (i Uint64) GetBSON() (interface{}, error) {
+	if !i.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Uint64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and binary values of subtype 4.
+func (u *UUID) SetBSON(raw bson.Raw) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.DecodeBSONUUID(raw.Kind, raw.Data, reflect.TypeOf(*u))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this UUID is null, and binary subtype 4 otherwise.
+func (u UUID) GetBSON() (interface{}, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONUUID(u.UUID)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (v *Value[T]) SetBSON(raw bson.Raw) error {
+	var err error
+	v.V, v.Valid, err = scalar.DecodeBSON[T](raw, reflect.TypeOf(*v))
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Value is null.
+func (v Value[T]) GetBSON() (interface{}, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(v.V)
+}
@@ -0,0 +1,271 @@
+//go:build mgo
+
+package null
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/decimal"
+	"github.com/conneqtech/null/uuid"
+	"github.com/globalsign/mgo/bson"
+)
+
+func TestBSONRoundTrip(t *testing.T) {
+	in := bsonDoc{
+		S:   StringFrom("test"),
+		I:   IntFrom(math.MaxInt64),
+		F:   FloatFrom(1.2345),
+		B:   BoolFrom(false),
+		T:   TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+		I8:  Int8From(-8),
+		U64: Uint64From(math.MaxUint64),
+		F32: Float32From(1.5),
+		V:   ValueFrom[uint16](0),
+	}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var out bsonDoc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.S != in.S || out.I != in.I || out.F != in.F || out.B != in.B || out.I8 != in.I8 ||
+		out.U64 != in.U64 || out.F32 != in.F32 || out.V != in.V {
+		t.Errorf("bad bson round trip: %+v ≠ %+v", out, in)
+	}
+	if !out.T.Time.Equal(in.T.Time) || !out.T.Valid {
+		t.Errorf("bad bson time round trip: %v ≠ %v", out.T, in.T)
+	}
+
+	data, err = bson.Marshal(bsonDoc{})
+	maybePanic(err)
+	out = bsonDoc{S: StringFrom("stale"), T: TimeFrom(time.Now())}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != (bsonDoc{}) {
+		t.Errorf("bson null should decode as null: %+v", out)
+	}
+}
+
+func TestBSONNumericKinds(t *testing.T) {
+	dec, err := bson.ParseDecimal128("1.2E+2")
+	maybePanic(err)
+	data, err := bson.Marshal(bson.M{
+		"i":  int32(120),
+		"i8": float64(120),
+		"f":  int64(120),
+		"v":  dec,
+	})
+	maybePanic(err)
+
+	var out bsonDoc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.I.Int64 != 120 || out.I8.Int8 != 120 || out.F.Float64 != 120 || out.V.V != 120 {
+		t.Errorf("bad numeric kind conversion: %+v", out)
+	}
+}
+
+func TestBSONDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  bson.M
+	}{
+		{"int8 overflow", bson.M{"i8": int64(300)}},
+		{"fractional double into int", bson.M{"i": 1.5}},
+		{"string into int", bson.M{"i": "12345"}},
+		{"int into string", bson.M{"s": 12345}},
+		{"int into bool", bson.M{"b": 1}},
+		{"string into time", bson.M{"t": "2012-12-21T21:21:21Z"}},
+		{"negative into uint", bson.M{"u64": -1}},
+	}
+	for _, tc := range tests {
+		data, err := bson.Marshal(tc.doc)
+		maybePanic(err)
+		var out bsonDoc
+		if err = bson.Unmarshal(data, &out); err == nil {
+			t.Errorf("%s: expected error, got %+v", tc.name, out)
+		}
+	}
+}
+
+func TestBSONDecimal(t *testing.T) {
+	type doc struct {
+		D Decimal
+		N Decimal
+	}
+	in := doc{D: DecimalFrom(decimal.MustParse("-1234.5678"))}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ D bson.Decimal128 }
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.D.String() != "-1234.5678" {
+		t.Errorf("bad bson decimal128: %v", raw.D)
+	}
+
+	out := doc{N: DecimalFrom(decimal.New(1, 0))}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.D.Valid || !out.D.Decimal.Equal(in.D.Decimal) || out.N.Valid {
+		t.Errorf("bad bson decimal round trip: %v ≠ %v", out, in)
+	}
+
+	data, err = bson.Marshal(bson.M{"d": 12})
+	maybePanic(err)
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.D.Decimal.String() != "12" {
+		t.Errorf("bad bson int32 into decimal: %v", out.D.Decimal)
+	}
+
+	data, err = bson.Marshal(bson.M{"d": "12"})
+	maybePanic(err)
+	if err = bson.Unmarshal(data, &out); err == nil {
+		t.Error("expected error for string into decimal")
+	}
+}
+
+func TestBSONUUID(t *testing.T) {
+	type doc struct {
+		U UUID
+		N UUID
+	}
+	in := doc{U: UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"))}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ U bson.Binary }
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.U.Kind != 0x04 || string(raw.U.Data) != string(in.U.UUID[:]) {
+		t.Errorf("bad bson uuid binary: %#v", raw.U)
+	}
+
+	out := doc{N: UUIDFrom(uuid.Nil)}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad bson uuid round trip: %v ≠ %v", out, in)
+	}
+
+	data, err = bson.Marshal(bson.M{"u": bson.Binary{Kind: 0x00, Data: in.U.UUID[:]}})
+	maybePanic(err)
+	if err = bson.Unmarshal(data, &out); err == nil {
+		t.Error("expected error for generic binary subtype")
+	}
+}
+
+func TestBSONBytes(t *testing.T) {
+	type doc struct{ B Bytes }
+	in := doc{B: BytesFrom([]byte{1, 2, 3})}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+	var out doc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if string(out.B.Bytes) != "\x01\x02\x03" || !out.B.Valid {
+		t.Errorf("bad bson bytes round trip: %+v", out)
+	}
+
+	data, err = bson.Marshal(bson.M{"b": bson.Binary{Kind: 0x02, Data: []byte{9}}})
+	maybePanic(err)
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if string(out.B.Bytes) != "\x09" {
+		t.Errorf("bad bson old binary subtype: %+v", out)
+	}
+}
+
+func TestBSONJSON(t *testing.T) {
+	type doc struct{ Meta JSON }
+	in := doc{Meta: JSONFrom([]byte(`{"b":1,"a":[true,null,"x",1.5,5000000000]}`))}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ Meta bson.D }
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if len(raw.Meta) != 2 || raw.Meta[0].Name != "b" || raw.Meta[0].Value != 1 {
+		t.Errorf("json should be stored as a subdocument in order: %#v", raw.Meta)
+	}
+
+	var out doc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertJSONEquals(t, out.Meta.JSON, `{"b":1,"a":[true,null,"x",1.5,5000000000]}`, "bson json round trip")
+
+	data, err = bson.Marshal(doc{})
+	maybePanic(err)
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.Meta.Valid {
+		t.Error("bson null json", "is valid, but should be invalid")
+	}
+}
+
+func TestBSONObject(t *testing.T) {
+	type doc struct{ FW Object[firmware] }
+	data, err := bson.Marshal(doc{FW: ObjectFrom(firmware{Version: "2.1", Build: 7})})
+	maybePanic(err)
+
+	var raw struct{ FW bson.M }
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.FW["version"] != "2.1" || raw.FW["build"] != 7 {
+		t.Errorf("object should be stored as a subdocument: %#v", raw.FW)
+	}
+
+	var out doc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.FW.Valid || out.FW.V.Version != "2.1" || out.FW.V.Build != 7 {
+		t.Errorf("bad bson object round trip: %+v", out.FW)
+	}
+
+	type stamped struct{ At time.Time }
+	data, err = bson.Marshal(struct{ O Object[stamped] }{ObjectFrom(stamped{At: timeValue})})
+	maybePanic(err)
+	var rawStamped struct{ O bson.M }
+	err = bson.Unmarshal(data, &rawStamped)
+	maybePanic(err)
+	if at, ok := rawStamped.O["at"].(time.Time); !ok || !at.Equal(timeValue) {
+		t.Errorf("object time should be stored as a datetime: %#v", rawStamped.O)
+	}
+	var outStamped struct{ O Object[stamped] }
+	err = bson.Unmarshal(data, &outStamped)
+	maybePanic(err)
+	if !outStamped.O.V.At.Equal(timeValue) {
+		t.Errorf("bad stamped object round trip: %+v", outStamped.O.V)
+	}
+
+	// mgo has no codec for channels, so this one goes through JSON.
+	type notifier struct {
+		N    int           `json:"n"`
+		Done chan struct{} `json:"-"`
+	}
+	data, err = bson.Marshal(struct{ O Object[notifier] }{ObjectFrom(notifier{N: 3})})
+	maybePanic(err)
+	var rawNotifier struct{ O bson.M }
+	err = bson.Unmarshal(data, &rawNotifier)
+	maybePanic(err)
+	if rawNotifier.O["n"] != 3 || len(rawNotifier.O) != 1 {
+		t.Errorf("object should be stored through its JSON form: %#v", rawNotifier.O)
+	}
+	var outNotifier struct{ O Object[notifier] }
+	err = bson.Unmarshal(data, &outNotifier)
+	maybePanic(err)
+	if !outNotifier.O.Valid || outNotifier.O.V.N != 3 {
+		t.Errorf("bad json fallback object round trip: %+v", outNotifier.O)
+	}
+
+	data, err = bson.Marshal(doc{})
+	maybePanic(err)
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.FW.Valid {
+		t.Error("bson null object", "is valid, but should be invalid")
+	}
+}
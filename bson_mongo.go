@@ -0,0 +1,531 @@
+//go:build mongo
+
+package null
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+	mongobson "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this BigInt is null, an int64 if the value fits,
+// and a decimal128 otherwise.
+func (i BigInt) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONBigInt(i.ValueOrZero())
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, int32, int64 and integral double and decimal128 values.
+func (i *BigInt) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.BigInt, i.Valid, err = scalar.DecodeBSONBigInt(byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Bool is null.
+func (b Bool) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !b.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(b.Bool)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (b *Bool) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	b.Bool, b.Valid, err = scalar.DecodeBSONValue[bool](byte(kind), data, reflect.TypeOf(*b))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Bytes is null, and generic binary otherwise.
+func (b Bytes) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !b.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONBytes(b.Bytes)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and binary values of any subtype.
+func (b *Bytes) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.DecodeBSONBytes(byte(kind), data, reflect.TypeOf(*b))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Date is null, and a datetime at midnight UTC otherwise.
+func (d Date) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !d.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONDate(d.Date)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, datetimes, read in UTC, and "2006-01-02" strings.
+func (d *Date) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Date, d.Valid, err = scalar.DecodeBSONDate(byte(kind), data, reflect.TypeOf(*d))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Decimal is null, and a decimal128 otherwise.
+func (d Decimal) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !d.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDecimal(d.Decimal)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, decimal128, int32, int64 and double values.
+func (d *Decimal) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.DecodeBSONDecimal(byte(kind), data, reflect.TypeOf(*d))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Duration is null, and a number of nanoseconds otherwise.
+func (d Duration) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !d.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration, time.Nanosecond)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, a number of nanoseconds, or a duration string.
+func (d *Duration) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.DecodeBSONDuration(byte(kind), data, reflect.TypeOf(*d), time.Nanosecond)
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this DurationSeconds is null, and a number of seconds otherwise.
+func (d DurationSeconds) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !d.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration.Duration, time.Second)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, a number of seconds, or a duration string.
+func (d *DurationSeconds) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.DecodeBSONDuration(byte(kind), data, reflect.TypeOf(*d), time.Second)
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Float is null.
+func (f Float) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !f.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(f.Float64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	f.Float64, f.Valid, err = scalar.DecodeBSONValue[float64](byte(kind), data, reflect.TypeOf(*f))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Float32 is null.
+func (f Float32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !f.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(f.Float32)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	f.Float32, f.Valid, err = scalar.DecodeBSONValue[float32](byte(kind), data, reflect.TypeOf(*f))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Float64 is null.
+func (f Float64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !f.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(f.Float64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (f *Float64) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	f.Float64, f.Valid, err = scalar.DecodeBSONValue[float64](byte(kind), data, reflect.TypeOf(*f))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int is null.
+func (i Int) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Int64, i.Valid, err = scalar.DecodeBSONValue[int64](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int8 is null.
+func (i Int8) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int8)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int8) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Int8, i.Valid, err = scalar.DecodeBSONValue[int8](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int16 is null.
+func (i Int16) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int16)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int16) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Int16, i.Valid, err = scalar.DecodeBSONValue[int16](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int32 is null.
+func (i Int32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int32)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Int32, i.Valid, err = scalar.DecodeBSONValue[int32](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int64 is null.
+func (i Int64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Int64) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Int64, i.Valid, err = scalar.DecodeBSONValue[int64](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this JSON is null. Objects are encoded as
+// subdocuments, arrays as arrays, and integers as int32 or int64.
+func (j JSON) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !j.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONJSON(j.JSON)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes any BSON value, so documents and arrays become JSON objects
+// and arrays. Datetimes, ObjectIDs and binary data become strings.
+func (j *JSON) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	j.JSON, j.Valid, err = scalar.DecodeBSONJSON(byte(kind), data, reflect.TypeOf(*j))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this LayoutTime is null,
+// and the time at the Precision of its format otherwise.
+func (t LayoutTime[L]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !t.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTime(t.Format().Store(t.Time.Time))
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It supports BSON datetime and null values.
+// The time is converted to the Location of the format, if it has one.
+func (t *LayoutTime[L]) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	err := t.Time.UnmarshalBSONValue(kind, data)
+	t.Time.Time = t.Format().Normalize(t.Time.Time)
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Object is null, and V with the driver's codec
+// otherwise, so a struct is stored as a subdocument and a time.Time field
+// as a datetime. If the driver cannot encode V, V is stored through its
+// JSON form instead.
+func (o Object[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !o.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := mongobson.MarshalValue(o.V)
+	if err == nil {
+		return kind, data, nil
+	}
+	doc, jsonErr := json.Marshal(o.V)
+	if jsonErr != nil {
+		return 0, nil, err
+	}
+	jsonKind, data, jsonErr := scalar.EncodeBSONJSON(doc)
+	if jsonErr != nil {
+		return 0, nil, err
+	}
+	return bsontype.Type(jsonKind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, and other values into V with the driver's codec.
+// If the driver cannot decode the value into V, it is decoded through
+// its JSON form instead, as MarshalBSONValue stores such values.
+func (o *Object[T]) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	if kind == bsontype.Null || kind == bsontype.Undefined {
+		return o.decode(nil, false)
+	}
+	var v T
+	err := mongobson.UnmarshalValue(kind, data, &v)
+	if err == nil {
+		o.V, o.Valid = v, true
+		return nil
+	}
+	doc, _, jsonErr := scalar.DecodeBSONJSON(byte(kind), data, reflect.TypeOf(*o))
+	if jsonErr != nil || o.decode(doc, true) != nil {
+		return err
+	}
+	return nil
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this String is null.
+func (s String) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !s.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(s.String)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (s *String) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	s.String, s.Valid, err = scalar.DecodeBSONValue[string](byte(kind), data, reflect.TypeOf(*s))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Time is null.
+func (t Time) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !t.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTime(t.Time)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It supports BSON datetime and null values.
+func (t *Time) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	t.Time, t.Valid, err = scalar.DecodeBSONTimeValue(byte(kind), data, reflect.TypeOf(*t))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this TimeOfDay is null, and a "15:04:05" string otherwise.
+func (t TimeOfDay) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !t.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTimeOfDay(t.TimeOfDay)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and "15:04:05" strings.
+func (t *TimeOfDay) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.DecodeBSONTimeOfDay(byte(kind), data, reflect.TypeOf(*t))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint8 is null.
+func (i Uint8) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Uint8)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint8) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Uint8, i.Valid, err = scalar.DecodeBSONValue[uint8](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint16 is null.
+func (i Uint16) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Uint16)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint16) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Uint16, i.Valid, err = scalar.DecodeBSONValue[uint16](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint32 is null.
+func (i Uint32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Uint32)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Uint32, i.Valid, err = scalar.DecodeBSONValue[uint32](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint64 is null.
+func (i Uint64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !i.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Uint64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (i *Uint64) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	i.Uint64, i.Valid, err = scalar.DecodeBSONValue[uint64](byte(kind), data, reflect.TypeOf(*i))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this UUID is null, and binary subtype 4 otherwise.
+func (u UUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !u.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONUUID(u.UUID)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and binary values of subtype 4.
+func (u *UUID) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.DecodeBSONUUID(byte(kind), data, reflect.TypeOf(*u))
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if !v.Valid {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(v.V)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+func (v *Value[T]) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	v.V, v.Valid, err = scalar.DecodeBSONValue[T](byte(kind), data, reflect.TypeOf(*v))
+	return err
+}
@@ -0,0 +1,374 @@
+//go:build mongo
+
+package null
+
+import (
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+	"github.com/conneqtech/null/decimal"
+	"github.com/conneqtech/null/uuid"
+	mongobson "go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestMongoDriverRoundTrip(t *testing.T) {
+	in := bsonDoc{
+		S:   StringFrom("test"),
+		I:   IntFrom(math.MinInt64),
+		F:   FloatFrom(1.2345),
+		B:   BoolFrom(true),
+		T:   TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+		I8:  Int8From(-8),
+		U64: Uint64From(math.MaxUint64),
+		F32: Float32From(1.5),
+		V:   ValueFrom[uint16](16),
+	}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var out bsonDoc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad mongo driver round trip: %+v ≠ %+v", out, in)
+	}
+
+	data, err = mongobson.Marshal(bsonDoc{})
+	maybePanic(err)
+	out = bsonDoc{S: StringFrom("stale"), T: TimeFrom(time.Now())}
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != (bsonDoc{}) {
+		t.Errorf("bson null should decode as null: %+v", out)
+	}
+
+	data, err = mongobson.Marshal(mongobson.M{"i8": int64(300)})
+	maybePanic(err)
+	if err = mongobson.Unmarshal(data, &out); err == nil {
+		t.Error("expected error for int8 overflow")
+	}
+}
+
+func TestMongoDriverDecimal(t *testing.T) {
+	type doc struct {
+		D Decimal
+		N Decimal
+	}
+	in := doc{D: DecimalFrom(decimal.MustParse("0.000000001"))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ D primitive.Decimal128 }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.D.String() != "1E-9" {
+		t.Errorf("bad bson decimal128: %v", raw.D)
+	}
+
+	out := doc{N: DecimalFrom(decimal.New(1, 0))}
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.D.Valid || !out.D.Decimal.Equal(in.D.Decimal) || out.N.Valid {
+		t.Errorf("bad bson decimal round trip: %v ≠ %v", out, in)
+	}
+}
+
+func TestMongoDriverBigInt(t *testing.T) {
+	type doc struct {
+		Small BigInt
+		Large BigInt
+		Null  BigInt
+	}
+	large, _ := new(big.Int).SetString("-1234567890123456789012345678901234", 10)
+	in := doc{Small: BigIntFrom(big.NewInt(-5)), Large: BigIntFrom(large)}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw mongobson.M
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw["small"] != int64(-5) || raw["null"] != nil {
+		t.Errorf("bad bson big int kinds: %#v", raw)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.Small.BigInt.Cmp(in.Small.BigInt) != 0 || out.Large.BigInt.Cmp(large) != 0 || out.Null.Valid {
+		t.Errorf("bad bson big int round trip: %v ≠ %v", out, in)
+	}
+
+	too, _ := new(big.Int).SetString("12345678901234567890123456789012345", 10)
+	if _, err = mongobson.Marshal(doc{Large: BigIntFrom(too)}); err == nil {
+		t.Error("expected error for a 35 digit big int")
+	}
+}
+
+func TestMongoDriverUUID(t *testing.T) {
+	type doc struct {
+		U UUID
+		N UUID
+	}
+	in := doc{U: UUIDFrom(uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479"))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ U primitive.Binary }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.U.Subtype != 0x04 || string(raw.U.Data) != string(in.U.UUID[:]) {
+		t.Errorf("bad bson uuid binary: %#v", raw.U)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad bson uuid round trip: %v ≠ %v", out, in)
+	}
+}
+
+func TestMongoDriverBytes(t *testing.T) {
+	type doc struct {
+		B     Bytes
+		Empty Bytes
+		Null  Bytes
+	}
+	in := doc{B: BytesFrom([]byte{1, 2, 3}), Empty: BytesFrom([]byte{})}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ B primitive.Binary }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.B.Subtype != 0x00 || string(raw.B.Data) != "\x01\x02\x03" {
+		t.Errorf("bad bson binary: %#v", raw.B)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if string(out.B.Bytes) != "\x01\x02\x03" || !out.Empty.Valid || out.Empty.Bytes == nil || out.Null.Valid {
+		t.Errorf("bad bson bytes round trip: %+v", out)
+	}
+}
+
+func TestMongoDriverJSON(t *testing.T) {
+	type doc struct{ Meta JSON }
+	in := doc{Meta: JSONFrom([]byte(`{"fw":{"major":2},"tags":["a"],"ratio":0.25}`))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ Meta mongobson.D }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if len(raw.Meta) != 3 || raw.Meta[0].Key != "fw" {
+		t.Errorf("json should be stored as a subdocument in order: %#v", raw.Meta)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	assertJSONEquals(t, out.Meta.JSON, `{"fw":{"major":2},"tags":["a"],"ratio":0.25}`, "mongo json round trip")
+
+	id := primitive.NewObjectID()
+	when := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	data, err = mongobson.Marshal(mongobson.M{"meta": mongobson.M{"id": id, "at": when}})
+	maybePanic(err)
+	var wrapped struct {
+		Meta JSON `bson:"meta"`
+	}
+	err = mongobson.Unmarshal(data, &wrapped)
+	maybePanic(err)
+	var got struct{ ID, At string }
+	err = wrapped.Meta.Unmarshal(&got)
+	maybePanic(err)
+	if got.ID != id.Hex() || got.At != "2024-05-01T12:00:00Z" {
+		t.Errorf("bad mongo json conversion: %s", wrapped.Meta.JSON)
+	}
+}
+
+func TestMongoDriverObject(t *testing.T) {
+	type doc struct{ FW Object[firmware] }
+	data, err := mongobson.Marshal(doc{FW: ObjectFrom(firmware{Version: "2.1", Flags: []string{"beta"}})})
+	maybePanic(err)
+
+	var raw struct{ FW mongobson.M }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.FW["version"] != "2.1" {
+		t.Errorf("object should be stored as a subdocument: %#v", raw.FW)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.FW.Valid || out.FW.V.Version != "2.1" || len(out.FW.V.Flags) != 1 {
+		t.Errorf("bad mongo object round trip: %+v", out.FW)
+	}
+
+	data, err = mongobson.Marshal(doc{})
+	maybePanic(err)
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.FW.Valid {
+		t.Error("bson null object", "is valid, but should be invalid")
+	}
+
+	// Objects use the driver's codec: times become datetimes.
+	type stamped struct {
+		At time.Time
+		N  int64
+	}
+	in := stamped{At: time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC), N: 1<<53 + 1}
+	data, err = mongobson.Marshal(struct{ O Object[stamped] }{ObjectFrom(in)})
+	maybePanic(err)
+	var rawStamped struct{ O mongobson.M }
+	err = mongobson.Unmarshal(data, &rawStamped)
+	maybePanic(err)
+	if at, ok := rawStamped.O["at"].(primitive.DateTime); !ok || !at.Time().Equal(in.At) || rawStamped.O["n"] != int64(1<<53+1) {
+		t.Errorf("object should be stored with BSON types: %#v", rawStamped.O)
+	}
+	var outStamped struct{ O Object[stamped] }
+	err = mongobson.Unmarshal(data, &outStamped)
+	maybePanic(err)
+	if !outStamped.O.V.At.Equal(in.At) || outStamped.O.V.N != in.N {
+		t.Errorf("bad stamped object round trip: %+v", outStamped.O.V)
+	}
+
+	// The driver has no codec for channels, so this one goes through JSON.
+	type notifier struct {
+		N    int           `json:"n"`
+		Done chan struct{} `json:"-"`
+	}
+	data, err = mongobson.Marshal(struct{ O Object[notifier] }{ObjectFrom(notifier{N: 3})})
+	maybePanic(err)
+	var rawNotifier struct{ O mongobson.M }
+	err = mongobson.Unmarshal(data, &rawNotifier)
+	maybePanic(err)
+	if rawNotifier.O["n"] != int32(3) || len(rawNotifier.O) != 1 {
+		t.Errorf("object should be stored through its JSON form: %#v", rawNotifier.O)
+	}
+	var outNotifier struct{ O Object[notifier] }
+	err = mongobson.Unmarshal(data, &outNotifier)
+	maybePanic(err)
+	if !outNotifier.O.Valid || outNotifier.O.V.N != 3 {
+		t.Errorf("bad json fallback object round trip: %+v", outNotifier.O)
+	}
+}
+
+func TestMongoDriverDuration(t *testing.T) {
+	type doc struct {
+		D Duration
+		S DurationSeconds
+		N Duration
+	}
+	in := doc{D: DurationFrom(90 * time.Minute), S: DurationSecondsFrom(1500 * time.Millisecond)}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct {
+		D int64
+		S float64
+	}
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.D != int64(90*time.Minute) || raw.S != 1.5 {
+		t.Errorf("bad bson durations: %+v", raw)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad bson duration round trip: %+v", out)
+	}
+}
+
+func TestMongoDriverDate(t *testing.T) {
+	type doc struct {
+		D Date
+		N Date
+	}
+	in := doc{D: DateFrom(civil.MustParseDate("2024-02-29"))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ D time.Time }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if !raw.D.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("date should be stored as midnight UTC: %v", raw.D)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad bson date round trip: %+v", out)
+	}
+}
+
+func TestMongoDriverTimeOfDay(t *testing.T) {
+	type doc struct {
+		T TimeOfDay
+		N TimeOfDay
+	}
+	in := doc{T: TimeOfDayFrom(civil.MustParseTimeOfDay("08:30:00.5"))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ T string }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.T != "08:30:00.5" {
+		t.Errorf("time of day should be stored as a string: %q", raw.T)
+	}
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad bson time of day round trip: %+v", out)
+	}
+}
+
+func TestMongoDriverLayoutTime(t *testing.T) {
+	type doc struct {
+		T LayoutTime[cetLayout]
+		N LayoutTime[cetLayout]
+	}
+	in := doc{T: LayoutTimeFrom[cetLayout](time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var out doc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if !out.T.Valid || !out.T.Time.Time.Equal(in.T.Time.Time) || out.T.Time.Time.Location().String() != "CET" {
+		t.Errorf("bson time should be decoded in the location of its format: %v", out.T.Time.Time)
+	}
+	if out.N.Valid {
+		t.Error("bson null should decode as null")
+	}
+}
+
+func TestMongoDriverLayoutTimePrecision(t *testing.T) {
+	type doc struct {
+		T LayoutTime[roundedMilliLayout]
+	}
+	in := doc{T: LayoutTimeFrom[roundedMilliLayout](time.Date(2012, 12, 21, 21, 21, 21, 123600000, time.UTC))}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var raw struct{ T time.Time }
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if raw.T.Nanosecond() != 124000000 {
+		t.Errorf("bson time should be rounded to the precision of its format: %v", raw.T)
+	}
+}
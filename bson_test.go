@@ -0,0 +1,15 @@
+//go:build mgo || mongo
+
+package null
+
+type bsonDoc struct {
+	S   String
+	I   Int
+	F   Float
+	B   Bool
+	T   Time
+	I8  Int8
+	U64 Uint64
+	F32 Float32
+	V   Value[uint16]
+}
@@ -0,0 +1,214 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Bytes is a nullable []byte, for BLOB and bytea columns.
+// It does not consider an empty slice to be null.
+// It will decode to null, not an empty slice, if null.
+// JSON and text use standard base64, like encoding/json does for []byte.
+// Use HexBytes or Base64URLBytes for other encodings.
+type Bytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is nil.
+// An empty, non-nil slice is valid.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, b != nil)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return NewBytes(*b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// Scan implements the Scanner interface.
+// The bytes are copied, so it is safe to scan sql.RawBytes.
+func (b *Bytes) Scan(value interface{}) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.ScanBytes(value, reflect.TypeOf(*b))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (b Bytes) Value() (driver.Value, error) {
+	if !b.Valid {
+		return nil, nil
+	}
+	if b.Bytes == nil {
+		return []byte{}, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+// A blank string will not be considered a null Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Base64)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Blank input will unmarshal to a valid empty slice, not a null Bytes.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Base64)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Bytes is null, and a base64 string otherwise.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalBytesJSON(b.Bytes, scalar.Base64)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Bytes is null. Text has no null
+// form, so that reads back as a valid empty slice; JSON keeps them apart.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Base64.EncodeToString(b.Bytes)), nil
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for invalid Bytes, for future omitempty support.
+// A non-null Bytes with an empty value will not be considered zero.
+func (b Bytes) IsZero() bool {
+	return !b.Valid
+}
+
+// HexBytes is a Bytes that is encoded as lower case hexadecimal in JSON and text.
+type HexBytes struct {
+	Bytes
+}
+
+// HexBytesFrom creates a new HexBytes that will be null if b is nil.
+func HexBytesFrom(b []byte) HexBytes {
+	return HexBytes{Bytes: BytesFrom(b)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports hexadecimal string and null input.
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Hex)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Blank input will unmarshal to a valid empty slice, not a null HexBytes.
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Hex)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this HexBytes is null, and a hexadecimal string otherwise.
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalBytesJSON(b.Bytes.Bytes, scalar.Hex)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this HexBytes is null. Text has no null
+// form, so that reads back as a valid empty slice; JSON keeps them apart.
+func (b HexBytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Hex.EncodeToString(b.Bytes.Bytes)), nil
+}
+
+// Base64URLBytes is a Bytes that is encoded as unpadded URL-safe base64
+// in JSON and text. Padded input is accepted too.
+type Base64URLBytes struct {
+	Bytes
+}
+
+// Base64URLBytesFrom creates a new Base64URLBytes that will be null if b is nil.
+func Base64URLBytesFrom(b []byte) Base64URLBytes {
+	return Base64URLBytes{Bytes: BytesFrom(b)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports URL-safe base64 string and null input.
+func (b *Base64URLBytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Base64URL)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Blank input will unmarshal to a valid empty slice, not a null Base64URLBytes.
+func (b *Base64URLBytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Base64URL)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Base64URLBytes is null, and a URL-safe base64 string otherwise.
+func (b Base64URLBytes) MarshalJSON() ([]byte, error) {
+	if !b.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalBytesJSON(b.Bytes.Bytes, scalar.Base64URL)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Base64URLBytes is null. Text has no null
+// form, so that reads back as a valid empty slice; JSON keeps them apart.
+func (b Base64URLBytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Base64URL.EncodeToString(b.Bytes.Bytes)), nil
+}
@@ -0,0 +1,117 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"testing"
+)
+
+func TestBytesJSON(t *testing.T) {
+	var b Bytes
+	err := json.Unmarshal([]byte(`"AQID/w=="`), &b)
+	maybePanic(err)
+	if !b.Valid || !bytes.Equal(b.Bytes, []byte{1, 2, 3, 255}) {
+		t.Errorf("bad bytes json: %+v", b)
+	}
+
+	err = json.Unmarshal(blankStringJSON, &b)
+	maybePanic(err)
+	if !b.Valid || b.Bytes == nil || len(b.Bytes) != 0 {
+		t.Errorf("blank json should be a valid empty slice: %+v", b)
+	}
+	data, err := json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "empty bytes json marshal")
+
+	err = json.Unmarshal(nullJSON, &b)
+	maybePanic(err)
+	if b.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(b)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null bytes json marshal")
+
+	if err = json.Unmarshal([]byte(`"not base64!"`), &b); err == nil {
+		t.Error("expected error for invalid base64")
+	}
+
+	data, err = json.Marshal(HexBytesFrom([]byte{0xde, 0xad, 0xbe, 0xef}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"deadbeef"`, "hex bytes json marshal")
+	var h HexBytes
+	err = json.Unmarshal([]byte(`"DEADBEEF"`), &h)
+	maybePanic(err)
+	if !h.Valid || !bytes.Equal(h.Bytes.Bytes, []byte{0xde, 0xad, 0xbe, 0xef}) {
+		t.Errorf("bad hex bytes json: %+v", h)
+	}
+
+	data, err = json.Marshal(Base64URLBytesFrom([]byte{0xfb, 0xff}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"-_8"`, "base64url bytes json marshal")
+	var u Base64URLBytes
+	err = json.Unmarshal([]byte(`"-_8="`), &u)
+	maybePanic(err)
+	if !bytes.Equal(u.Bytes.Bytes, []byte{0xfb, 0xff}) {
+		t.Errorf("bad padded base64url bytes json: %+v", u)
+	}
+}
+
+func TestBytesSQL(t *testing.T) {
+	raw := sql.RawBytes("firmware")
+	var b Bytes
+	err := b.Scan([]byte(raw))
+	maybePanic(err)
+	raw[0] = 'F'
+	if string(b.Bytes) != "firmware" {
+		t.Errorf("scan should copy the driver buffer: %q", b.Bytes)
+	}
+
+	err = b.Scan([]byte{})
+	maybePanic(err)
+	if !b.Valid || b.Bytes == nil {
+		t.Errorf("empty scan should be a valid empty slice: %+v", b)
+	}
+	v, err := b.Value()
+	maybePanic(err)
+	if v, ok := v.([]byte); !ok || v == nil {
+		t.Errorf("empty bytes driver value should be an empty slice, not %#v", v)
+	}
+
+	err = b.Scan(nil)
+	maybePanic(err)
+	if v, _ = b.Value(); v != nil {
+		t.Errorf("null bytes driver value should be nil, not %#v", v)
+	}
+
+	if BytesFrom(nil).Valid || !BytesFrom([]byte{}).Valid {
+		t.Error("BytesFrom should only be null for a nil slice")
+	}
+
+	empty := BytesFrom([]byte{})
+	data, err := json.Marshal(empty)
+	maybePanic(err)
+	var fromJSON Bytes
+	err = json.Unmarshal(data, &fromJSON)
+	maybePanic(err)
+	txt, err := empty.MarshalText()
+	maybePanic(err)
+	var fromText Bytes
+	err = fromText.UnmarshalText(txt)
+	maybePanic(err)
+	for _, rt := range []Bytes{fromJSON, fromText} {
+		if !rt.Valid || rt.Bytes == nil || len(rt.Bytes) != 0 {
+			t.Errorf("empty bytes should round trip as a valid empty slice, got %+v", rt)
+		}
+	}
+
+	txt, err = HexBytesFrom([]byte{1, 2}).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "0102", "hex bytes text marshal")
+	err = b.UnmarshalText([]byte("AQI="))
+	maybePanic(err)
+	if !bytes.Equal(b.Bytes, []byte{1, 2}) {
+		t.Errorf("bad bytes text: %+v", b)
+	}
+}
@@ -0,0 +1,142 @@
+// Package civil provides calendar and clock values that have no time zone,
+// the value types of null.Date, null.TimeOfDay and their zero counterparts.
+// A Date is a day on the calendar, such as a birthdate or the start
+// of a contract, that means the same day wherever it is read.
+// A TimeOfDay is a wall clock time, such as an opening hour.
+package civil
+
+import (
+	"fmt"
+	"time"
+)
+
+// DateLayout is the layout of a Date in time.Parse form, as used by
+// SQL DATE columns and ISO 8601.
+const DateLayout = "2006-01-02"
+
+// secondsPerDay is the length of a calendar day, which is always
+// 24 hours in UTC.
+const secondsPerDay = 24 * 60 * 60
+
+// epochDays is the number of days from January 1, year 1,
+// to the Unix epoch.
+const epochDays = 719162
+
+// Date is a calendar date in the proleptic Gregorian calendar.
+// The zero value is January 1, year 1, the date of time.Time{}.
+// Dates are comparable with ==.
+type Date struct {
+	days int // days since January 1, year 1
+}
+
+// NewDate returns the Date of the given year, month and day.
+// Like time.Date, values outside their usual ranges are normalized,
+// so NewDate(2024, 2, 30) is March 1, 2024.
+func NewDate(year int, month time.Month, day int) Date {
+	return DateOf(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// DateOf returns the date of t in its own location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	midnight := time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()
+	return Date{days: int(floorDiv(midnight, secondsPerDay)) + epochDays}
+}
+
+// ParseDate parses a date in "2006-01-02" form.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(DateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("civil: invalid date %q", s)
+	}
+	return DateOf(t), nil
+}
+
+// MustParseDate is like ParseDate, but panics if s is not a date.
+func MustParseDate(s string) Date {
+	d, err := ParseDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// In returns the time at midnight at the start of d in loc.
+// It panics if loc is nil, like time.Date.
+func (d Date) In(loc *time.Location) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, 0, 0, 0, 0, loc)
+}
+
+// Date returns the year, month and day of d.
+func (d Date) Date() (year int, month time.Month, day int) {
+	return d.utc().Date()
+}
+
+// Year returns the year of d.
+func (d Date) Year() int {
+	return d.utc().Year()
+}
+
+// Month returns the month of d.
+func (d Date) Month() time.Month {
+	return d.utc().Month()
+}
+
+// Day returns the day of the month of d.
+func (d Date) Day() int {
+	return d.utc().Day()
+}
+
+// Weekday returns the day of the week of d.
+func (d Date) Weekday() time.Weekday {
+	return d.utc().Weekday()
+}
+
+// AddDays returns d plus n days. n may be negative.
+func (d Date) AddDays(n int) Date {
+	return Date{days: d.days + n}
+}
+
+// AddDate returns d plus the given years, months and days,
+// normalized like time.Time.AddDate.
+func (d Date) AddDate(years, months, days int) Date {
+	return DateOf(d.utc().AddDate(years, months, days))
+}
+
+// Sub returns the number of days from e to d.
+func (d Date) Sub(e Date) int {
+	return d.days - e.days
+}
+
+// Before reports whether d is before e.
+func (d Date) Before(e Date) bool {
+	return d.days < e.days
+}
+
+// After reports whether d is after e.
+func (d Date) After(e Date) bool {
+	return d.days > e.days
+}
+
+// IsZero reports whether d is the zero Date, January 1, year 1.
+func (d Date) IsZero() bool {
+	return d.days == 0
+}
+
+// String returns d in "2006-01-02" form.
+func (d Date) String() string {
+	return d.utc().Format(DateLayout)
+}
+
+func (d Date) utc() time.Time {
+	return time.Unix(int64(d.days-epochDays)*secondsPerDay, 0).UTC()
+}
+
+func floorDiv(a, b int64) int64 {
+	q := a / b
+	if a%b < 0 {
+		q--
+	}
+	return q
+}
@@ -0,0 +1,65 @@
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateZero(t *testing.T) {
+	var d Date
+	if d != DateOf(time.Time{}) {
+		t.Errorf("zero Date should be the date of time.Time{}, not %v", DateOf(time.Time{}))
+	}
+	if !d.IsZero() || d.String() != "0001-01-01" {
+		t.Errorf("bad zero Date: %v", d)
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	tm := time.Date(2024, time.March, 1, 22, 30, 0, 0, loc)
+	if got := DateOf(tm); got != NewDate(2024, time.March, 1) {
+		t.Errorf("DateOf should use the time's own location: %v", got)
+	}
+	if got := DateOf(tm.UTC()); got != NewDate(2024, time.March, 2) {
+		t.Errorf("bad DateOf in UTC: %v", got)
+	}
+	if got := NewDate(1969, time.December, 31); got.String() != "1969-12-31" {
+		t.Errorf("bad date before the Unix epoch: %v", got)
+	}
+	if got := NewDate(2023, time.February, 29); got.String() != "2023-03-01" {
+		t.Errorf("NewDate should normalize: %v", got)
+	}
+	if got := NewDate(2024, time.March, 1).In(loc); !got.Equal(time.Date(2024, time.March, 1, 0, 0, 0, 0, loc)) {
+		t.Errorf("bad In: %v", got)
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	d, err := ParseDate("2024-02-29")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if y, m, day := d.Date(); y != 2024 || m != time.February || day != 29 || d.Weekday() != time.Thursday {
+		t.Errorf("bad parsed date: %v", d)
+	}
+	for _, in := range []string{"", "2023-02-29", "2024-2-29", "2024-02-29T00:00:00Z", "29/02/2024"} {
+		if _, err := ParseDate(in); err == nil {
+			t.Errorf("ParseDate(%q): expected error", in)
+		}
+	}
+}
+
+func TestDateArithmetic(t *testing.T) {
+	d := MustParseDate("2024-01-31")
+	if got := d.AddDays(1); got.String() != "2024-02-01" {
+		t.Errorf("bad AddDays: %v", got)
+	}
+	if got := d.AddDate(0, 1, 0); got.String() != "2024-03-02" {
+		t.Errorf("bad AddDate: %v", got)
+	}
+	e := MustParseDate("2025-01-31")
+	if e.Sub(d) != 366 || !d.Before(e) || !e.After(d) {
+		t.Errorf("bad comparison of %v and %v", d, e)
+	}
+}
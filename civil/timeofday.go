@@ -0,0 +1,158 @@
+package civil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// day is the length of a TimeOfDay cycle.
+const day = 24 * time.Hour
+
+// TimeOfDay is a wall clock time without a date or zone, such as an
+// opening hour, with nanosecond precision. The zero value is midnight.
+// TimeOfDays are comparable with ==.
+type TimeOfDay struct {
+	ns time.Duration // time since midnight, in [0, 24h)
+}
+
+// NewTimeOfDay returns the TimeOfDay of the given hour, minute, second
+// and nanosecond. Values outside their usual ranges are normalized and
+// wrap around midnight, so NewTimeOfDay(25, 0, 0, 0) is 01:00.
+func NewTimeOfDay(hour, min, sec, nsec int) TimeOfDay {
+	d := time.Duration(hour)*time.Hour + time.Duration(min)*time.Minute +
+		time.Duration(sec)*time.Second + time.Duration(nsec)
+	return TimeOfDay{}.Add(d)
+}
+
+// TimeOfDayOf returns the wall clock time of t in its own location.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	h, m, s := t.Clock()
+	return NewTimeOfDay(h, m, s, t.Nanosecond())
+}
+
+// ParseTimeOfDay parses a time in "15:04:05" form, with an optional
+// fraction of a second of up to nine digits ("15:04:05.999999"),
+// or in "15:04" form.
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	clock, frac, hasFrac := strings.Cut(s, ".")
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 || (hasFrac && len(parts) != 3) {
+		return TimeOfDay{}, timeSyntaxError(s)
+	}
+	var fields [3]int
+	limits := [3]int{24, 60, 60}
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || len(p) != 2 || n >= limits[i] {
+			return TimeOfDay{}, timeSyntaxError(s)
+		}
+		fields[i] = n
+	}
+	var nsec int
+	if hasFrac {
+		if frac == "" || len(frac) > 9 || strings.Trim(frac, "0123456789") != "" {
+			return TimeOfDay{}, timeSyntaxError(s)
+		}
+		nsec, _ = strconv.Atoi(frac + strings.Repeat("0", 9-len(frac)))
+	}
+	return NewTimeOfDay(fields[0], fields[1], fields[2], nsec), nil
+}
+
+// MustParseTimeOfDay is like ParseTimeOfDay, but panics if s is not a time.
+func MustParseTimeOfDay(s string) TimeOfDay {
+	t, err := ParseTimeOfDay(s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func timeSyntaxError(s string) error {
+	return fmt.Errorf("civil: invalid time of day %q", s)
+}
+
+// Hour returns the hour of t, in [0, 23].
+func (t TimeOfDay) Hour() int {
+	return int(t.ns / time.Hour)
+}
+
+// Minute returns the minute of t, in [0, 59].
+func (t TimeOfDay) Minute() int {
+	return int(t.ns % time.Hour / time.Minute)
+}
+
+// Second returns the second of t, in [0, 59].
+func (t TimeOfDay) Second() int {
+	return int(t.ns % time.Minute / time.Second)
+}
+
+// Nanosecond returns the nanosecond within the second of t.
+func (t TimeOfDay) Nanosecond() int {
+	return int(t.ns % time.Second)
+}
+
+// SinceMidnight returns the time elapsed from midnight to t.
+func (t TimeOfDay) SinceMidnight() time.Duration {
+	return t.ns
+}
+
+// Add returns t plus d, wrapping around midnight. d may be negative.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	ns := (t.ns + d%day) % day
+	if ns < 0 {
+		ns += day
+	}
+	return TimeOfDay{ns: ns}
+}
+
+// Sub returns t - u, which is negative if t is before u.
+// It does not wrap around midnight.
+func (t TimeOfDay) Sub(u TimeOfDay) time.Duration {
+	return t.ns - u.ns
+}
+
+// Before reports whether t is before u on the same day.
+func (t TimeOfDay) Before(u TimeOfDay) bool {
+	return t.ns < u.ns
+}
+
+// After reports whether t is after u on the same day.
+func (t TimeOfDay) After(u TimeOfDay) bool {
+	return t.ns > u.ns
+}
+
+// Compare returns -1 if t is before u, +1 if t is after u, and 0 if they are equal.
+func (t TimeOfDay) Compare(u TimeOfDay) int {
+	switch {
+	case t.ns < u.ns:
+		return -1
+	case t.ns > u.ns:
+		return 1
+	}
+	return 0
+}
+
+// On returns the time at t on date d in loc.
+// It panics if loc is nil, like time.Date.
+func (t TimeOfDay) On(d Date, loc *time.Location) time.Time {
+	y, m, day := d.Date()
+	return time.Date(y, m, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// IsZero reports whether t is midnight.
+func (t TimeOfDay) IsZero() bool {
+	return t.ns == 0
+}
+
+// String returns t in "15:04:05" form, followed by the fraction of
+// a second without trailing zeros if it is not a whole second,
+// such as "15:04:05.5".
+func (t TimeOfDay) String() string {
+	s := fmt.Sprintf("%02d:%02d:%02d", t.Hour(), t.Minute(), t.Second())
+	if ns := t.Nanosecond(); ns != 0 {
+		s += strings.TrimRight(fmt.Sprintf(".%09d", ns), "0")
+	}
+	return s
+}
@@ -0,0 +1,74 @@
+package civil
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	table := []struct {
+		in, want string
+	}{
+		{"15:04:05", "15:04:05"},
+		{"15:04", "15:04:00"},
+		{"00:00:00", "00:00:00"},
+		{"23:59:59.999999", "23:59:59.999999"},
+		{"08:30:00.500", "08:30:00.5"},
+		{"08:30:00.123456789", "08:30:00.123456789"},
+	}
+	for _, test := range table {
+		got, err := ParseTimeOfDay(test.in)
+		if err != nil {
+			t.Errorf("ParseTimeOfDay(%q): %v", test.in, err)
+			continue
+		}
+		if got.String() != test.want {
+			t.Errorf("ParseTimeOfDay(%q) = %v, want %v", test.in, got, test.want)
+		}
+	}
+
+	for _, in := range []string{"", "24:00:00", "8:30:00", "08:60:00", "08:30:00.", "08:30.5", "08:30:00.1234567890", "08:30:00Z", "-01:00:00"} {
+		if _, err := ParseTimeOfDay(in); err == nil {
+			t.Errorf("ParseTimeOfDay(%q): expected error", in)
+		}
+	}
+}
+
+func TestTimeOfDayArithmetic(t *testing.T) {
+	closing := MustParseTimeOfDay("22:00:00")
+	if got := closing.Add(3 * time.Hour); got.String() != "01:00:00" {
+		t.Errorf("Add should wrap around midnight: %v", got)
+	}
+	if got := MustParseTimeOfDay("01:00:00").Add(-2 * time.Hour); got != closing.Add(time.Hour) {
+		t.Errorf("negative Add should wrap around midnight: %v", got)
+	}
+	if got := closing.Add(49 * time.Hour); got.String() != "23:00:00" {
+		t.Errorf("Add should wrap several days: %v", got)
+	}
+	if got := NewTimeOfDay(25, 0, 0, 0); got.String() != "01:00:00" {
+		t.Errorf("NewTimeOfDay should normalize: %v", got)
+	}
+
+	opening := MustParseTimeOfDay("08:30:00")
+	if closing.Sub(opening) != 13*time.Hour+30*time.Minute || opening.Sub(closing) >= 0 {
+		t.Errorf("bad Sub of %v and %v", closing, opening)
+	}
+	if !opening.Before(closing) || !closing.After(opening) || opening.Compare(closing) != -1 || opening.Compare(opening) != 0 {
+		t.Errorf("bad comparison of %v and %v", opening, closing)
+	}
+	if !(TimeOfDay{}).IsZero() || opening.IsZero() {
+		t.Error("only midnight should be zero")
+	}
+}
+
+func TestTimeOfDayOf(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	tm := time.Date(2024, 5, 1, 8, 30, 15, 250, loc)
+	tod := TimeOfDayOf(tm)
+	if tod.Hour() != 8 || tod.Minute() != 30 || tod.Second() != 15 || tod.Nanosecond() != 250 {
+		t.Errorf("bad TimeOfDayOf: %v", tod)
+	}
+	if got := tod.On(NewDate(2024, 5, 1), loc); !got.Equal(tm) {
+		t.Errorf("bad On: %v", got)
+	}
+}
@@ -0,0 +1,31 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Convert converts src, any nullable type of this package or zero,
+// into the nullable type D, for example Convert[Int8](i) for an Int i.
+// Null stays null. Unlike a plain Go conversion such as Int8From(int8(i.Int64)),
+// it never truncates: it returns an error if the value is out of range for D
+// or would lose precision, as 0.1 does when converted to a Float32.
+func Convert[D any, PD interface {
+	*D
+	sql.Scanner
+	driver.Valuer
+}](src driver.Valuer) (D, error) {
+	return scalar.Convert[D, PD](src)
+}
+
+// ConvertOK is like Convert, but reports failure with ok instead of an error.
+func ConvertOK[D any, PD interface {
+	*D
+	sql.Scanner
+	driver.Valuer
+}](src driver.Valuer) (dst D, ok bool) {
+	dst, err := scalar.Convert[D, PD](src)
+	return dst, err == nil
+}
@@ -0,0 +1,64 @@
+package null
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	i8, err := Convert[Int8](IntFrom(-128))
+	maybePanic(err)
+	if i8 != Int8From(-128) {
+		t.Errorf("Convert[Int8](-128) = %+v", i8)
+	}
+
+	if _, err = Convert[Int8](IntFrom(128)); err == nil {
+		t.Error("expected error for int8 overflow")
+	}
+	if _, err = Convert[Uint16](Int32From(-1)); err == nil {
+		t.Error("expected error for negative uint16")
+	}
+
+	n, err := Convert[Int8](NewInt(5, false))
+	maybePanic(err)
+	if n.Valid {
+		t.Error("converted null", "is valid, but should be invalid")
+	}
+
+	f32, err := Convert[Float32](FloatFrom(1.5))
+	maybePanic(err)
+	if f32 != Float32From(1.5) {
+		t.Errorf("Convert[Float32](1.5) = %+v", f32)
+	}
+	if _, err = Convert[Float32](FloatFrom(0.1)); err == nil {
+		t.Error("expected error for float32 precision loss")
+	}
+	if _, err = Convert[Int](FloatFrom(1.5)); err == nil {
+		t.Error("expected error for fractional int")
+	}
+	if _, err = Convert[Float32](IntFrom(1<<24 + 1)); err == nil {
+		t.Error("expected error for float32 precision loss of an integer")
+	}
+
+	widened, err := Convert[Float](Float32From(0.1))
+	maybePanic(err)
+	if widened.Float64 != float64(float32(0.1)) {
+		t.Errorf("Convert[Float](float32 0.1) = %+v", widened)
+	}
+
+	u64, err := Convert[Value[uint64]](Uint64From(math.MaxUint64))
+	maybePanic(err)
+	if u64.V != math.MaxUint64 {
+		t.Errorf("Convert[Value[uint64]](MaxUint64) = %+v", u64)
+	}
+	if _, err = Convert[Int](Uint64From(math.MaxUint64)); err == nil {
+		t.Error("expected error for int64 overflow")
+	}
+
+	if _, ok := ConvertOK[Uint8](IntFrom(300)); ok {
+		t.Error("ConvertOK(300) to Uint8 should not be ok")
+	}
+	if u8, ok := ConvertOK[Uint8](Int16From(255)); !ok || u8 != Uint8From(255) {
+		t.Errorf("ConvertOK(255) to Uint8 = %+v, %v", u8, ok)
+	}
+}
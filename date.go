@@ -0,0 +1,150 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Date is a nullable calendar date without a time of day or zone,
+// for SQL DATE columns such as birthdates.
+// It marshals to JSON as "2006-01-02".
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Date struct {
+	Date  civil.Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NewDate creates a new Date
+func NewDate(d civil.Date, valid bool) Date {
+	return Date{
+		Date:  d,
+		Valid: valid,
+	}
+}
+
+// DateFrom creates a new Date that will always be valid.
+func DateFrom(d civil.Date) Date {
+	return NewDate(d, true)
+}
+
+// DateFromPtr creates a new Date that will be null if d is nil.
+func DateFromPtr(d *civil.Date) Date {
+	if d == nil {
+		return NewDate(civil.Date{}, false)
+	}
+	return NewDate(*d, true)
+}
+
+// DateFromTime creates a new Date holding the date of t in loc.
+// It will be null if t is null. If loc is nil, t's own location is used.
+func DateFromTime(t Time, loc *time.Location) Date {
+	if !t.Valid {
+		return Date{}
+	}
+	if loc != nil {
+		return DateFrom(civil.DateOf(t.Time.In(loc)))
+	}
+	return DateFrom(civil.DateOf(t.Time))
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Date) ValueOrZero() civil.Date {
+	if !d.Valid {
+		return civil.Date{}
+	}
+	return d.Date
+}
+
+// TimeIn returns a Time at midnight at the start of this Date in loc,
+// or a null Time if this Date is null.
+func (d Date) TimeIn(loc *time.Location) Time {
+	if !d.Valid {
+		return Time{}
+	}
+	return TimeFrom(d.Date.In(loc))
+}
+
+// Scan implements the Scanner interface.
+// It accepts time.Time values, read on their own wall clock,
+// and "2006-01-02" strings and []byte values.
+func (d *Date) Scan(value interface{}) error {
+	var err error
+	d.Date, d.Valid, err = scalar.ScanDate(value, reflect.TypeOf(*d))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The date is passed as a "2006-01-02" string, which DATE columns accept
+// without the session time zone shifting it to another day.
+func (d Date) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Date.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "2006-01-02" string and null input.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to dates.
+func (d *Date) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Date, d.Valid, err = scalar.UnmarshalDateJSON(data, reflect.TypeOf(*d), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Date if the input is blank or "null".
+// It will return an error if the input is not a date, blank, or "null".
+func (d *Date) UnmarshalText(text []byte) error {
+	var err error
+	d.Date, d.Valid, err = scalar.UnmarshalDateText(text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Date is null, and "2006-01-02" otherwise.
+func (d Date) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalDateJSON(d.Date), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Date is null.
+func (d Date) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Date.String()), nil
+}
+
+// SetValid changes this Date's value and also sets it to be non-null.
+func (d *Date) SetValid(v civil.Date) {
+	d.Date = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Date's value, or a nil pointer if this Date is null.
+func (d Date) Ptr() *civil.Date {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Date
+}
+
+// IsZero returns true for invalid Dates, for future omitempty support.
+// A non-null Date with a zero value will not be considered zero.
+func (d Date) IsZero() bool {
+	return !d.Valid
+}
@@ -0,0 +1,109 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+var (
+	dateJSON = []byte(`"2024-02-29"`)
+	leapDay  = civil.MustParseDate("2024-02-29")
+)
+
+func TestDateJSON(t *testing.T) {
+	var d Date
+	err := json.Unmarshal(dateJSON, &d)
+	maybePanic(err)
+	if !d.Valid || d.Date != leapDay {
+		t.Errorf("bad date json: %+v", d)
+	}
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(dateJSON), "date json marshal")
+
+	err = json.Unmarshal(nullJSON, &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null date json marshal")
+
+	for _, in := range []string{`"2024-02-29T00:00:00Z"`, `"2023-02-29"`, `20240229`, `true`} {
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestDateSQL(t *testing.T) {
+	var d Date
+	for _, src := range []interface{}{
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 0, 0, 0, 0, time.FixedZone("UTC+9", 9*60*60)),
+		"2024-02-29",
+		[]byte("2024-02-29 00:00:00"),
+	} {
+		err := d.Scan(src)
+		maybePanic(err)
+		if !d.Valid || d.Date != leapDay {
+			t.Errorf("bad date scan of %v: %+v", src, d)
+		}
+	}
+	v, err := d.Value()
+	maybePanic(err)
+	if v != "2024-02-29" {
+		t.Errorf("bad date driver value: %#v", v)
+	}
+
+	err = d.Scan(nil)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("scanned nil", "is valid, but should be invalid")
+	}
+	if err = d.Scan("29/02/2024"); err == nil || strings.Count(err.Error(), "29/02/2024") != 1 {
+		t.Errorf("expected a descriptive error scanning an invalid date, got %v", err)
+	}
+}
+
+func TestDateTime(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	tm := TimeFrom(time.Date(2024, 3, 1, 2, 0, 0, 0, time.UTC))
+	if got := DateFromTime(tm, loc); got.Date != leapDay {
+		t.Errorf("bad DateFromTime in a location: %v", got.Date)
+	}
+	if got := DateFromTime(tm, nil); got.Date.String() != "2024-03-01" {
+		t.Errorf("bad DateFromTime in the time's location: %v", got.Date)
+	}
+	if DateFromTime(Time{}, loc).Valid {
+		t.Error("DateFromTime of a null Time should be null")
+	}
+
+	got := DateFrom(leapDay).TimeIn(loc)
+	if !got.Valid || !got.Time.Equal(time.Date(2024, 2, 29, 0, 0, 0, 0, loc)) {
+		t.Errorf("bad TimeIn: %v", got.Time)
+	}
+	if (Date{}).TimeIn(loc).Valid {
+		t.Error("TimeIn of a null Date should be null")
+	}
+}
+
+func TestDateText(t *testing.T) {
+	var d Date
+	err := d.UnmarshalText([]byte("2024-02-29"))
+	maybePanic(err)
+	txt, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "2024-02-29", "date text")
+
+	err = d.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if d.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,203 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/decimal"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Decimal is a nullable exact decimal number, for money and other values
+// that a Float cannot store exactly. It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+type Decimal struct {
+	Decimal decimal.Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// NewDecimal creates a new Decimal
+func NewDecimal(d decimal.Decimal, valid bool) Decimal {
+	return Decimal{
+		Decimal: d,
+		Valid:   valid,
+	}
+}
+
+// DecimalFrom creates a new Decimal that will always be valid.
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return NewDecimal(d, true)
+}
+
+// DecimalFromPtr creates a new Decimal that be null if d is nil.
+func DecimalFromPtr(d *decimal.Decimal) Decimal {
+	if d == nil {
+		return NewDecimal(decimal.Decimal{}, false)
+	}
+	return NewDecimal(*d, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Decimal) ValueOrZero() decimal.Decimal {
+	if !d.Valid {
+		return decimal.Decimal{}
+	}
+	return d.Decimal
+}
+
+// Scan implements the Scanner interface.
+// It accepts the string and []byte values drivers return for NUMERIC
+// columns, as well as integers and floats.
+func (d *Decimal) Scan(value interface{}) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.ScanDecimal(value, reflect.TypeOf(*d))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The decimal is passed as a string, which NUMERIC columns accept without loss.
+func (d Decimal) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return d.Decimal.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *Decimal) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.UnmarshalDecimalJSON(data, reflect.TypeOf(*d), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank or "null".
+// It will return an error if the input is not a number, blank, or "null".
+func (d *Decimal) UnmarshalText(text []byte) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.UnmarshalDecimalText(text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Decimal is null, and a JSON number otherwise.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(d.Decimal.String()), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Decimal is null.
+func (d Decimal) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Decimal.String()), nil
+}
+
+// SetValid changes this Decimal's value and also sets it to be non-null.
+func (d *Decimal) SetValid(v decimal.Decimal) {
+	d.Decimal = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Decimal's value, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+// IsZero returns true for invalid Decimals, for future omitempty support.
+// A non-null Decimal with a 0 value will not be considered zero.
+func (d Decimal) IsZero() bool {
+	return !d.Valid
+}
+
+// Add returns d + y. Like SQL, the result is null if either is null.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Add(y Decimal) (Decimal, error) {
+	if !d.Valid || !y.Valid {
+		return Decimal{}, nil
+	}
+	r, err := d.Decimal.Add(y.Decimal)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Sub returns d - y. Like SQL, the result is null if either is null.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Sub(y Decimal) (Decimal, error) {
+	if !d.Valid || !y.Valid {
+		return Decimal{}, nil
+	}
+	r, err := d.Decimal.Sub(y.Decimal)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Mul returns d × y. Like SQL, the result is null if either is null.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Mul(y Decimal) (Decimal, error) {
+	if !d.Valid || !y.Valid {
+		return Decimal{}, nil
+	}
+	r, err := d.Decimal.Mul(y.Decimal)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Quo returns d / y rounded to the given number of decimal places.
+// Like SQL, the result is null if either is null, and dividing
+// a non-null value by zero returns decimal.ErrDivisionByZero.
+func (d Decimal) Quo(y Decimal, places int, mode decimal.RoundingMode) (Decimal, error) {
+	if !d.Valid || !y.Valid {
+		return Decimal{}, nil
+	}
+	q, err := d.Decimal.Quo(y.Decimal, places, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(q), nil
+}
+
+// Neg returns -d, or null if d is null.
+func (d Decimal) Neg() Decimal {
+	if !d.Valid {
+		return Decimal{}
+	}
+	return DecimalFrom(d.Decimal.Neg())
+}
+
+// Abs returns the absolute value of d, or null if d is null.
+func (d Decimal) Abs() Decimal {
+	if !d.Valid {
+		return Decimal{}
+	}
+	return DecimalFrom(d.Decimal.Abs())
+}
+
+// Round returns d rounded to the given number of decimal places,
+// or null if d is null. See decimal.Decimal.Round.
+func (d Decimal) Round(places int, mode decimal.RoundingMode) Decimal {
+	if !d.Valid {
+		return Decimal{}
+	}
+	return DecimalFrom(d.Decimal.Round(places, mode))
+}
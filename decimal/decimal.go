@@ -0,0 +1,333 @@
+// Package decimal provides an arbitrary-precision decimal number,
+// for values such as money and meter readings that a float64
+// cannot store exactly. It is the value type of null.Decimal and zero.Decimal.
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxExponent is the largest exponent, positive or negative, that Parse
+// accepts and that Add, Sub and Mul work with. It bounds the size of the
+// numbers that untrusted input can create.
+const MaxExponent = 1 << 17
+
+var (
+	// ErrDivisionByZero is returned by Quo when dividing by zero.
+	ErrDivisionByZero = errors.New("decimal: division by zero")
+	// ErrExponentRange is returned by Add, Sub and Mul when an operand or
+	// the result has an exponent beyond MaxExponent.
+	ErrExponentRange = errors.New("decimal: exponent out of range")
+)
+
+// Decimal is the exact number coef × 10^exp.
+// The zero value is 0. Decimals are immutable and safe to copy.
+// The exponent is kept as given, so "1.50" has two decimal places like
+// a NUMERIC(x, 2) value, and is equal to but not identical to "1.5".
+type Decimal struct {
+	coef *big.Int // nil means zero; never modified once set
+	exp  int
+}
+
+// RoundingMode is how Round and Quo discard digits.
+type RoundingMode int
+
+const (
+	// HalfUp rounds to the nearest value, and ties away from zero.
+	// This is how SQL ROUND behaves for NUMERIC values.
+	HalfUp RoundingMode = iota
+	// HalfEven rounds to the nearest value, and ties to the even digit.
+	HalfEven
+	// Down rounds toward zero, truncating.
+	Down
+	// Up rounds away from zero.
+	Up
+	// Floor rounds toward negative infinity.
+	Floor
+	// Ceiling rounds toward positive infinity.
+	Ceiling
+)
+
+// New returns the Decimal coef × 10^exp.
+func New(coef int64, exp int) Decimal {
+	return Decimal{coef: big.NewInt(coef), exp: exp}
+}
+
+// NewFromBigInt returns the Decimal coef × 10^exp.
+func NewFromBigInt(coef *big.Int, exp int) Decimal {
+	return Decimal{coef: new(big.Int).Set(coef), exp: exp}
+}
+
+// NewFromFloat returns the shortest Decimal that converts back to f.
+// It returns an error for infinities and NaN.
+func NewFromFloat(f float64) (Decimal, error) {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return Decimal{}, fmt.Errorf("decimal: cannot represent %v", f)
+	}
+	return Parse(strconv.FormatFloat(f, 'g', -1, 64))
+}
+
+// Parse parses a decimal number such as "-12.50" or "1.2e-3".
+// Infinities and NaN are not numbers and are rejected.
+func Parse(s string) (Decimal, error) {
+	mant, exp := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		mant = s[:i]
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil || e > MaxExponent || e < -MaxExponent {
+			return Decimal{}, syntaxError(s)
+		}
+		exp = e
+	}
+	neg := false
+	if mant != "" && (mant[0] == '-' || mant[0] == '+') {
+		neg = mant[0] == '-'
+		mant = mant[1:]
+	}
+	digits, frac := mant, ""
+	if i := strings.IndexByte(mant, '.'); i >= 0 {
+		digits, frac = mant[:i], mant[i+1:]
+	}
+	digits += frac
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return Decimal{}, syntaxError(s)
+	}
+	exp -= len(frac)
+	if exp < -MaxExponent {
+		return Decimal{}, syntaxError(s)
+	}
+	coef, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		coef.Neg(coef)
+	}
+	return Decimal{coef: coef, exp: exp}, nil
+}
+
+// MustParse is like Parse, but panics if s is not a decimal number.
+func MustParse(s string) Decimal {
+	d, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func syntaxError(s string) error {
+	return fmt.Errorf("decimal: invalid syntax: %q", s)
+}
+
+// Coefficient returns a copy of the coefficient of d.
+func (d Decimal) Coefficient() *big.Int {
+	return new(big.Int).Set(d.coefficient())
+}
+
+// Exponent returns the exponent of d.
+func (d Decimal) Exponent() int {
+	return d.exp
+}
+
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// String returns d in plain notation, such as "-0.0012" or "1200",
+// keeping its trailing zeros.
+func (d Decimal) String() string {
+	coef := d.coefficient()
+	digits := new(big.Int).Abs(coef).String()
+	switch {
+	case d.exp >= 0:
+		digits += strings.Repeat("0", d.exp)
+	case len(digits) <= -d.exp:
+		digits = "0." + strings.Repeat("0", -d.exp-len(digits)) + digits
+	default:
+		point := len(digits) + d.exp
+		digits = digits[:point] + "." + digits[point:]
+	}
+	if coef.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of d.
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero reports whether d is 0, at any exponent.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and y and returns -1, 0 or +1.
+func (d Decimal) Cmp(y Decimal) int {
+	ds, ys := d.Sign(), y.Sign()
+	switch {
+	case ds < ys:
+		return -1
+	case ds > ys:
+		return 1
+	case ds == 0:
+		return 0
+	}
+	// Numbers of different magnitudes are ordered without aligning them,
+	// so comparing never scales a coefficient by a large power of ten.
+	if dm, ym := d.magnitude(), y.magnitude(); dm != ym {
+		if dm > ym {
+			return ds
+		}
+		return -ds
+	}
+	a, b, _ := align(d, y)
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and y are the same number, such as "1.5" and "1.50".
+func (d Decimal) Equal(y Decimal) bool {
+	return d.Cmp(y) == 0
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.coefficient()), exp: d.exp}
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.coefficient()), exp: d.exp}
+}
+
+// Add returns d + y, with the larger number of decimal places of the two.
+// It returns ErrExponentRange if either exponent is beyond MaxExponent.
+func (d Decimal) Add(y Decimal) (Decimal, error) {
+	if !inRange(d.exp) || !inRange(y.exp) {
+		return Decimal{}, ErrExponentRange
+	}
+	a, b, exp := align(d, y)
+	return Decimal{coef: a.Add(a, b), exp: exp}, nil
+}
+
+// Sub returns d - y, with the larger number of decimal places of the two.
+// It returns ErrExponentRange if either exponent is beyond MaxExponent.
+func (d Decimal) Sub(y Decimal) (Decimal, error) {
+	if !inRange(d.exp) || !inRange(y.exp) {
+		return Decimal{}, ErrExponentRange
+	}
+	a, b, exp := align(d, y)
+	return Decimal{coef: a.Sub(a, b), exp: exp}, nil
+}
+
+// Mul returns d × y exactly.
+// It returns ErrExponentRange if the exponent of the result is beyond MaxExponent.
+func (d Decimal) Mul(y Decimal) (Decimal, error) {
+	exp := d.exp + y.exp
+	if !inRange(d.exp) || !inRange(y.exp) || !inRange(exp) {
+		return Decimal{}, ErrExponentRange
+	}
+	return Decimal{coef: new(big.Int).Mul(d.coefficient(), y.coefficient()), exp: exp}, nil
+}
+
+// Quo returns d / y rounded to the given number of decimal places.
+// It returns ErrDivisionByZero if y is zero.
+func (d Decimal) Quo(y Decimal, places int, mode RoundingMode) (Decimal, error) {
+	if y.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	num, den := d.Coefficient(), y.Coefficient()
+	if k := d.exp - y.exp + places; k >= 0 {
+		num.Mul(num, pow10(k))
+	} else {
+		den.Mul(den, pow10(-k))
+	}
+	return Decimal{coef: quoRound(num, den, mode), exp: -places}, nil
+}
+
+// Round returns d rounded to the given number of decimal places.
+// Like SQL ROUND, the result always has exactly that many places,
+// so rounding 1.5 to two places gives 1.50. places may be negative
+// to round to tens, hundreds and so on.
+func (d Decimal) Round(places int, mode RoundingMode) Decimal {
+	coef := d.coefficient()
+	if k := d.exp + places; k >= 0 {
+		return Decimal{coef: new(big.Int).Mul(coef, pow10(k)), exp: -places}
+	}
+	return Decimal{coef: quoRound(coef, pow10(-d.exp-places), mode), exp: -places}
+}
+
+// Rat returns d as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	r := new(big.Rat).SetInt(d.coefficient())
+	if d.exp >= 0 {
+		return r.Mul(r, new(big.Rat).SetInt(pow10(d.exp)))
+	}
+	return r.Quo(r, new(big.Rat).SetInt(pow10(-d.exp)))
+}
+
+// Float64 returns the float64 nearest to d, and whether it is exact.
+func (d Decimal) Float64() (f float64, exact bool) {
+	return d.Rat().Float64()
+}
+
+// align returns the coefficients of x and y at their smaller exponent.
+// The coefficients are new values that may be modified.
+func align(x, y Decimal) (a, b *big.Int, exp int) {
+	a, b = x.Coefficient(), y.Coefficient()
+	switch {
+	case x.exp > y.exp:
+		a.Mul(a, pow10(x.exp-y.exp))
+		return a, b, y.exp
+	case y.exp > x.exp:
+		b.Mul(b, pow10(y.exp-x.exp))
+	}
+	return a, b, x.exp
+}
+
+// magnitude returns the exponent of the leading digit of d, so that
+// d is at least 10^(magnitude-1) and less than 10^magnitude. d must not be 0.
+func (d Decimal) magnitude() int {
+	return d.exp + len(new(big.Int).Abs(d.coef).Text(10))
+}
+
+func inRange(exp int) bool {
+	return exp >= -MaxExponent && exp <= MaxExponent
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// quoRound returns num / den rounded according to mode.
+func quoRound(num, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	sign := num.Sign() * den.Sign()
+	half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).CmpAbs(den)
+	var away bool
+	switch mode {
+	case HalfUp:
+		away = half >= 0
+	case HalfEven:
+		away = half > 0 || half == 0 && q.Bit(0) == 1
+	case Up:
+		away = true
+	case Floor:
+		away = sign < 0
+	case Ceiling:
+		away = sign > 0
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
@@ -0,0 +1,115 @@
+package decimal
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0", "0"},
+		{"-12.50", "-12.50"},
+		{"+1", "1"},
+		{".5", "0.5"},
+		{"1.2e-3", "0.0012"},
+		{"1.2E3", "1200"},
+		{"12345678901234567890.123456789", "12345678901234567890.123456789"},
+	}
+	for _, tc := range tests {
+		d, err := Parse(tc.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tc.in, err)
+			continue
+		}
+		if d.String() != tc.want {
+			t.Errorf("Parse(%q) = %s, want %s", tc.in, d, tc.want)
+		}
+	}
+
+	for _, in := range []string{"", "-", ".", "1.2.3", "NaN", "Inf", "1e", "0x10", "1e999999"} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q): expected error", in)
+		}
+	}
+
+	if (Decimal{}).String() != "0" {
+		t.Error("zero Decimal should be 0")
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	a, b := MustParse("10.25"), MustParse("0.1")
+	if r, err := a.Add(b); err != nil || r.String() != "10.35" {
+		t.Errorf("Add = %s, %v", r, err)
+	}
+	if r, err := b.Sub(a); err != nil || r.String() != "-10.15" {
+		t.Errorf("Sub = %s, %v", r, err)
+	}
+	if r, err := a.Mul(b); err != nil || r.String() != "1.025" {
+		t.Errorf("Mul = %s, %v", r, err)
+	}
+	q, err := MustParse("1").Quo(MustParse("3"), 4, HalfUp)
+	if err != nil || q.String() != "0.3333" {
+		t.Errorf("Quo = %s, %v", q, err)
+	}
+	if _, err = a.Quo(Decimal{}, 2, HalfUp); err != ErrDivisionByZero {
+		t.Errorf("Quo by zero: %v", err)
+	}
+	if !MustParse("1.5").Equal(MustParse("1.50")) || MustParse("-1").Cmp(MustParse("0.1")) != -1 {
+		t.Error("bad comparison")
+	}
+	if f, exact := MustParse("0.5").Float64(); f != 0.5 || !exact {
+		t.Errorf("Float64 = %v, %v", f, exact)
+	}
+	if r := MustParse("0.25").Rat(); r.Cmp(big.NewRat(1, 4)) != 0 {
+		t.Errorf("Rat = %v", r)
+	}
+}
+
+func TestExponentRange(t *testing.T) {
+	huge, tiny := New(1, 1<<40), New(1, -(1<<40))
+	if _, err := huge.Add(tiny); err != ErrExponentRange {
+		t.Errorf("Add out of range: %v", err)
+	}
+	if _, err := tiny.Sub(MustParse("1")); err != ErrExponentRange {
+		t.Errorf("Sub out of range: %v", err)
+	}
+	large := New(1, MaxExponent)
+	if _, err := large.Mul(large); err != ErrExponentRange {
+		t.Errorf("Mul out of range: %v", err)
+	}
+	if huge.Cmp(tiny) != 1 || tiny.Cmp(huge) != -1 || huge.Neg().Cmp(tiny) != -1 {
+		t.Error("bad comparison of distant exponents")
+	}
+	if !New(10, 0).Equal(New(1, 1)) || New(99, 0).Cmp(New(1, 2)) != -1 {
+		t.Error("bad comparison of equal magnitudes")
+	}
+}
+
+func TestRound(t *testing.T) {
+	tests := []struct {
+		in     string
+		places int
+		mode   RoundingMode
+		want   string
+	}{
+		{"2.345", 2, HalfUp, "2.35"},
+		{"-2.345", 2, HalfUp, "-2.35"},
+		{"2.345", 2, HalfEven, "2.34"},
+		{"2.355", 2, HalfEven, "2.36"},
+		{"2.349", 2, Down, "2.34"},
+		{"2.341", 2, Up, "2.35"},
+		{"-2.341", 2, Floor, "-2.35"},
+		{"-2.349", 2, Ceiling, "-2.34"},
+		{"1.5", 2, HalfUp, "1.50"},
+		{"1250", -2, HalfEven, "1200"},
+	}
+	for _, tc := range tests {
+		if got := MustParse(tc.in).Round(tc.places, tc.mode).String(); got != tc.want {
+			t.Errorf("Round(%s, %d, %d) = %s, want %s", tc.in, tc.places, tc.mode, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conneqtech/null/decimal"
+)
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal([]byte(`12345678901234567890.01`), &d)
+	maybePanic(err)
+	if d.Decimal.String() != "12345678901234567890.01" || !d.Valid {
+		t.Errorf("bad decimal json: %v", d.Decimal)
+	}
+
+	err = json.Unmarshal([]byte(`1e400`), &d)
+	maybePanic(err)
+	if !d.Valid || !d.Decimal.Equal(decimal.New(1, 400)) {
+		t.Errorf("bad large decimal json: %v", d.Decimal)
+	}
+
+	err = json.Unmarshal([]byte(`"0.10"`), &d)
+	maybePanic(err)
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0.10", "decimal json marshal")
+
+	if err = d.UnmarshalJSONWith([]byte(`"0.10"`), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for decimal string")
+	}
+
+	err = json.Unmarshal(nullJSON, &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null decimal json marshal")
+
+	if err = json.Unmarshal(boolJSON, &d); err == nil {
+		t.Error("expected error for bool json")
+	}
+}
+
+func TestDecimalSQL(t *testing.T) {
+	var d Decimal
+	err := d.Scan([]byte("-1.23"))
+	maybePanic(err)
+	if d.Decimal.String() != "-1.23" || !d.Valid {
+		t.Errorf("bad decimal scan: %v", d.Decimal)
+	}
+	v, err := d.Value()
+	maybePanic(err)
+	if v != "-1.23" {
+		t.Errorf("bad decimal driver value: %#v", v)
+	}
+
+	err = d.Scan(int64(5))
+	maybePanic(err)
+	if d.Decimal.String() != "5" {
+		t.Errorf("bad decimal scan of int64: %v", d.Decimal)
+	}
+
+	if err = d.Scan("abc"); err == nil {
+		t.Error("expected error for invalid decimal")
+	}
+
+	err = d.Scan(nil)
+	maybePanic(err)
+	if v, _ = d.Value(); v != nil {
+		t.Errorf("null decimal driver value should be nil, not %#v", v)
+	}
+
+	err = d.UnmarshalText([]byte("7.5"))
+	maybePanic(err)
+	txt, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "7.5", "decimal text marshal")
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := DecimalFrom(decimal.MustParse("10.25"))
+	b := DecimalFrom(decimal.MustParse("0.1"))
+	sum, err := a.Add(b)
+	maybePanic(err)
+	if s := sum.Decimal.String(); s != "10.35" {
+		t.Errorf("Add = %s", s)
+	}
+	sum, _ = a.Add(Decimal{})
+	prod, _ := (Decimal{}).Mul(b)
+	if sum.Valid || prod.Valid || (Decimal{}).Round(2, decimal.HalfUp).Valid {
+		t.Error("arithmetic with null should be null")
+	}
+	if _, err = a.Mul(DecimalFrom(decimal.New(1, 1<<40))); err != decimal.ErrExponentRange {
+		t.Errorf("Mul out of range: %v", err)
+	}
+	q, err := a.Quo(b, 2, decimal.HalfUp)
+	maybePanic(err)
+	if q.Decimal.String() != "102.50" {
+		t.Errorf("Quo = %s", q.Decimal)
+	}
+	if q, err = a.Quo(Decimal{}, 2, decimal.HalfUp); err != nil || q.Valid {
+		t.Errorf("Quo by null = %v, %v", q, err)
+	}
+	if _, err = a.Quo(DecimalFrom(decimal.Decimal{}), 2, decimal.HalfUp); err == nil {
+		t.Error("expected error for division by zero")
+	}
+	if p := (Decimal{}).Ptr(); p != nil {
+		t.Error("null decimal Ptr should be nil")
+	}
+}
@@ -0,0 +1,231 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Duration is a nullable time.Duration.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+//
+// Input may be a Go duration string ("1h30m"), an ISO 8601 duration
+// ("PT1H30M"), a Postgres interval ("01:30:00") or an integer number of
+// nanoseconds. Duration marshals to a Go duration string; DurationISO
+// and DurationSeconds marshal to the other common forms.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// NewDuration creates a new Duration
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// DurationFrom creates a new Duration that will always be valid.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, true)
+}
+
+// DurationFromPtr creates a new Duration that will be null if d is nil.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return NewDuration(*d, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// Scan implements the Scanner interface.
+// Integers are nanoseconds, as BIGINT columns written by Value hold them.
+// Strings may also hold a Postgres interval or any other accepted form.
+func (d *Duration) Scan(value interface{}) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.ScanDuration(value, reflect.TypeOf(*d), time.Nanosecond)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The duration is passed as an int64 number of nanoseconds.
+func (d Duration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Duration.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *Duration) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.UnmarshalDurationJSON(data, reflect.TypeOf(*d), p, time.Nanosecond)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Duration if the input is blank or "null".
+// It will return an error if the input is not a duration, blank, or "null".
+func (d *Duration) UnmarshalText(text []byte) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.UnmarshalDurationText(text, time.Nanosecond)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Duration is null, and a Go duration string such as "1h30m0s" otherwise.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.Quote(d.Duration.String())), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Duration is null.
+func (d Duration) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(d.Duration.String()), nil
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// IsZero returns true for invalid Durations, for future omitempty support.
+// A non-null Duration with a 0 value will not be considered zero.
+func (d Duration) IsZero() bool {
+	return !d.Valid
+}
+
+// DurationISO is a nullable time.Duration that is encoded as an ISO 8601
+// duration, such as "PT1H30M", for JSON and text.
+// Everything else, including its input and SQL, behaves like Duration.
+type DurationISO struct {
+	Duration
+}
+
+// DurationISOFrom creates a new DurationISO that will always be valid.
+func DurationISOFrom(d time.Duration) DurationISO {
+	return DurationISO{Duration: DurationFrom(d)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DurationISO is null.
+func (d DurationISO) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + scalar.FormatISODuration(d.Duration.Duration) + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this DurationISO is null.
+func (d DurationISO) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.FormatISODuration(d.Duration.Duration)), nil
+}
+
+// DurationSeconds is a nullable time.Duration that is a number of seconds,
+// such as 5400 or 1.5, everywhere: in JSON, text, SQL and BSON.
+// It suits BIGINT columns and APIs that count timeouts in seconds.
+// Duration strings are accepted as input too.
+type DurationSeconds struct {
+	Duration
+}
+
+// DurationSecondsFrom creates a new DurationSeconds that will always be valid.
+func DurationSecondsFrom(d time.Duration) DurationSeconds {
+	return DurationSeconds{Duration: DurationFrom(d)}
+}
+
+// Scan implements the Scanner interface.
+// Integers and floats are a number of seconds.
+func (d *DurationSeconds) Scan(value interface{}) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.ScanDuration(value, reflect.TypeOf(*d), time.Second)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The duration is passed as an int64 number of seconds,
+// or a float64 if it is not a whole number of seconds.
+func (d DurationSeconds) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil
+	}
+	return scalar.DurationDriverValue(d.Duration.Duration, time.Second), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input. Numbers are seconds.
+func (d *DurationSeconds) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *DurationSeconds) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.UnmarshalDurationJSON(data, reflect.TypeOf(*d), p, time.Second)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null DurationSeconds if the input is blank or "null".
+func (d *DurationSeconds) UnmarshalText(text []byte) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.UnmarshalDurationText(text, time.Second)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this DurationSeconds is null, and a number of seconds otherwise.
+func (d DurationSeconds) MarshalJSON() ([]byte, error) {
+	if !d.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(scalar.FormatDuration(d.Duration.Duration, time.Second)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this DurationSeconds is null.
+func (d DurationSeconds) MarshalText() ([]byte, error) {
+	if !d.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.FormatDuration(d.Duration.Duration, time.Second)), nil
+}
@@ -0,0 +1,156 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDurationParse(t *testing.T) {
+	table := []struct {
+		in   string
+		want time.Duration
+	}{
+		{`"1h30m"`, 90 * time.Minute},
+		{`"PT1H30M"`, 90 * time.Minute},
+		{`"P1DT2H"`, 26 * time.Hour},
+		{`"P1W"`, 7 * 24 * time.Hour},
+		{`"-PT0.5S"`, -500 * time.Millisecond},
+		{`"PT1,5S"`, 1500 * time.Millisecond},
+		{`"01:30:00"`, 90 * time.Minute},
+		{`"-00:00:01.25"`, -1250 * time.Millisecond},
+		{`"1 day 02:00:00"`, 26 * time.Hour},
+		{`"-1 days +02:00:00"`, -22 * time.Hour},
+		{`"@ 1 hour 30 mins"`, 90 * time.Minute},
+		{`5400000000000`, 90 * time.Minute},
+		{`0`, 0},
+	}
+	for _, test := range table {
+		var d Duration
+		if err := json.Unmarshal([]byte(test.in), &d); err != nil {
+			t.Errorf("%s: unexpected error: %v", test.in, err)
+			continue
+		}
+		if !d.Valid || d.Duration != test.want {
+			t.Errorf("%s: got %v, want %v", test.in, d.Duration, test.want)
+		}
+	}
+
+	for _, in := range []string{`"P1Y"`, `"P2M"`, `"1 mon"`, `"soon"`, `"P"`, `"PT"`, `true`, `{}`, `"1e40"`, `"1/3 hours"`, `"0x10 mins"`, `"PT1.S"`, `"PT.5S"`, `"PT1,2,3S"`} {
+		var d Duration
+		if err := json.Unmarshal([]byte(in), &d); err == nil {
+			t.Errorf("%s: expected error, got %v", in, d.Duration)
+		}
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	var d Duration
+	err := json.Unmarshal(nullJSON, &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+
+	data, err := json.Marshal(DurationFrom(90 * time.Minute))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1h30m0s"`, "duration json marshal")
+	data, err = json.Marshal(Duration{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null duration json marshal")
+
+	data, err = json.Marshal(DurationISOFrom(90*time.Minute + 1500*time.Millisecond))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"PT1H30M1.5S"`, "iso duration json marshal")
+	data, err = json.Marshal(DurationISOFrom(0))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"PT0S"`, "zero iso duration json marshal")
+
+	data, err = json.Marshal(DurationSecondsFrom(1500 * time.Millisecond))
+	maybePanic(err)
+	assertJSONEquals(t, data, `1.5`, "seconds duration json marshal")
+	var s DurationSeconds
+	err = json.Unmarshal([]byte(`5400`), &s)
+	maybePanic(err)
+	if s.Duration.Duration != 90*time.Minute {
+		t.Errorf("bad seconds duration json: %v", s.Duration.Duration)
+	}
+	err = json.Unmarshal([]byte(`"PT2M"`), &s)
+	maybePanic(err)
+	if s.Duration.Duration != 2*time.Minute {
+		t.Errorf("seconds duration should accept strings: %v", s.Duration.Duration)
+	}
+
+	err = d.UnmarshalJSONWith([]byte(`"90"`), StrictPolicy())
+	if err == nil {
+		t.Error("strict policy should reject numeric strings")
+	}
+	err = d.UnmarshalJSONWith([]byte(`"90"`), DefaultPolicy())
+	maybePanic(err)
+	if d.Duration != 90 {
+		t.Errorf("numeric string should be nanoseconds: %v", d.Duration)
+	}
+}
+
+func TestDurationSQL(t *testing.T) {
+	var d Duration
+	err := d.Scan(int64(time.Second))
+	maybePanic(err)
+	if d.Duration != time.Second {
+		t.Errorf("bad bigint duration scan: %v", d.Duration)
+	}
+	err = d.Scan([]byte("3 days 04:05:06.5"))
+	maybePanic(err)
+	if want := 76*time.Hour + 5*time.Minute + 6500*time.Millisecond; d.Duration != want {
+		t.Errorf("bad interval duration scan: %v, want %v", d.Duration, want)
+	}
+	v, err := d.Value()
+	maybePanic(err)
+	if v != int64(d.Duration) {
+		t.Errorf("bad duration driver value: %#v", v)
+	}
+	err = d.Scan(nil)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("scanned nil", "is valid, but should be invalid")
+	}
+	if err = d.Scan("1 year"); err == nil {
+		t.Error("expected error scanning an interval with years")
+	}
+	for _, in := range []string{"PT", "P1Y"} {
+		if err = d.Scan(in); err == nil || strings.Count(err.Error(), in) != 1 {
+			t.Errorf("%s: expected a descriptive error, got %v", in, err)
+		}
+	}
+
+	var s DurationSeconds
+	err = s.Scan(int64(30))
+	maybePanic(err)
+	if s.Duration.Duration != 30*time.Second {
+		t.Errorf("bad seconds duration scan: %v", s.Duration.Duration)
+	}
+	v, err = DurationSecondsFrom(1500 * time.Millisecond).Value()
+	maybePanic(err)
+	if v != 1.5 {
+		t.Errorf("bad fractional seconds driver value: %#v", v)
+	}
+}
+
+func TestDurationText(t *testing.T) {
+	var d Duration
+	err := d.UnmarshalText([]byte("PT10S"))
+	maybePanic(err)
+	txt, err := d.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "10s", "duration text")
+
+	err = d.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if d.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+	txt, err = DurationISOFrom(-time.Hour).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "-PT1H", "iso duration text")
+}
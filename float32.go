@@ -1,15 +1,13 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
-	"math"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
-// Float3232 is a nullable float32.
+// Float32 is a nullable float32.
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
 type Float32 struct {
@@ -17,7 +15,7 @@ type Float32 struct {
 	Valid   bool // Valid is true if Float32 is not NULL
 }
 
-// NewFloat3232 creates a new Float3232
+// NewFloat32 creates a new Float32
 func NewFloat32(f float32, valid bool) Float32 {
 	return Float32{
 		Float32: f,
@@ -25,7 +23,7 @@ func NewFloat32(f float32, valid bool) Float32 {
 	}
 }
 
-// Float3232From creates a new Float3232 that will always be valid.
+// Float32From creates a new Float32 that will always be valid.
 func Float32From(f float32) Float32 {
 	return NewFloat32(f, true)
 }
@@ -46,58 +44,47 @@ func (f Float32) ValueOrZero() float32 {
 	return f.Float32
 }
 
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a float32.
+func (f *Float32) Scan(value interface{}) error {
+	var err error
+	f.Float32, f.Valid, err = scalar.Scan[float32](value, reflect.TypeOf(*f))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (f Float32) Value() (driver.Value, error) {
+	if !f.Valid {
+		return nil, nil
+	}
+	return scalar.DriverValue(f.Float32)
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
-// It supports number and null input.
+// It supports number, string and null input.
 // 0 will not be considered a null Float32.
-// It also supports unmarshalling a sql.NullFloat3232.
 func (f *Float32) UnmarshalJSON(data []byte) error {
+	return f.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (f *Float32) UnmarshalJSONWith(data []byte, p Policy) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float32:
-		f.Float32 = float32(x)
-	case float64:
-		f.Float32 = float32(x)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			f.Valid = false
-			return nil
-		}
-		parsedFloat, err := strconv.ParseFloat(str, 32)
-		if err != nil {
-			f.Float32 = float32(parsedFloat)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &f.Float32)
-	case nil:
-		f.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float32", reflect.TypeOf(v).Name())
-	}
-	f.Valid = err == nil
+	f.Float32, f.Valid, err = scalar.UnmarshalJSONPolicy[float32](data, reflect.TypeOf(*f), p)
 	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Float32 if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Float32 if the input is blank or "null".
+// It will return an error if the input is not a number, blank, or "null".
 func (f *Float32) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		f.Valid = false
-		return nil
-	}
+	return f.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (f *Float32) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedFloat, err := strconv.ParseFloat(string(text), 32)
-	if err != nil {
-		f.Float32 = float32(parsedFloat)
-	}
-	f.Valid = err == nil
+	f.Float32, f.Valid, err = scalar.UnmarshalTextPolicy[float32](text, p)
 	return err
 }
 
@@ -107,25 +94,7 @@ func (f Float32) MarshalJSON() ([]byte, error) {
 	if !f.Valid {
 		return []byte("null"), nil
 	}
-	castedFloat := float64(f.Float32)
-	if math.IsInf(castedFloat, 0) || math.IsNaN(castedFloat) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float32),
-			Str:   strconv.FormatFloat(castedFloat, 'g', -1, 32),
-		}
-	}
-	return []byte(strconv.FormatFloat(castedFloat, 'f', -1, 32)), nil
-}
-
-func (t *Float32) SetBSON(raw bson.Raw) error {
-	return t.UnmarshalJSON(raw.Data)
-}
-
-func (t Float32) GetBSON() (interface{}, error) {
-	if !t.Valid {
-		return nil, nil
-	}
-	return t.Float32, nil
+	return scalar.MarshalJSON(f.Float32)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -134,7 +103,7 @@ func (f Float32) MarshalText() ([]byte, error) {
 	if !f.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatFloat(float64(f.Float32), 'f', -1, 32)), nil
+	return []byte(scalar.Format(f.Float32)), nil
 }
 
 // SetValid changes this Float32's value and also sets it to be non-null.
@@ -151,7 +120,7 @@ func (f Float32) Ptr() *float32 {
 	return &f.Float32
 }
 
-// IsZero returns true for invalid Float32s, for future omitempty support (Go 1.4?)
+// IsZero returns true for invalid Float32s, for future omitempty support.
 // A non-null Float32 with a 0 value will not be considered zero.
 func (f Float32) IsZero() bool {
 	return !f.Valid
@@ -2,22 +2,19 @@ package null
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
-	"math"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
-// Float is a nullable float64.
+// Float64 is a nullable float64.
 // It does not consider zero values to be null.
 // It will decode to null, not zero, if null.
 type Float64 struct {
 	sql.NullFloat64
 }
 
-// NewFloat creates a new Float
+// NewFloat64 creates a new Float64
 func NewFloat64(f float64, valid bool) Float64 {
 	return Float64{
 		NullFloat64: sql.NullFloat64{
@@ -27,12 +24,12 @@ func NewFloat64(f float64, valid bool) Float64 {
 	}
 }
 
-// FloatFrom creates a new Float that will always be valid.
+// Float64From creates a new Float64 that will always be valid.
 func Float64From(f float64) Float64 {
 	return NewFloat64(f, true)
 }
 
-// FloatFromPtr creates a new Float that be null if f is nil.
+// Float64FromPtr creates a new Float64 that be null if f is nil.
 func Float64FromPtr(f *float64) Float64 {
 	if f == nil {
 		return NewFloat64(0, false)
@@ -53,48 +50,27 @@ func (f Float64) ValueOrZero() float64 {
 // 0 will not be considered a null Float64.
 // It also supports unmarshalling a sql.NullFloat64.
 func (f *Float64) UnmarshalJSON(data []byte) error {
+	return f.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (f *Float64) UnmarshalJSONWith(data []byte, p Policy) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-	case float32:
-		f.Float64 = float64(x)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			f.Valid = false
-			return nil
-		}
-		f.Float64, err = strconv.ParseFloat(str, 64)
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &f.NullFloat64)
-	case nil:
-		f.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Float", reflect.TypeOf(v).Name())
-	}
-	f.Valid = err == nil
+	f.Float64, f.Valid, err = scalar.UnmarshalJSONPolicy[float64](data, reflect.TypeOf(*f), p)
 	return err
 }
 
-
-
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Float if the input is a blank or not an integer.
 // It will return an error if the input is not an integer, blank, or "null".
 func (f *Float64) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		f.Valid = false
-		return nil
-	}
+	return f.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (f *Float64) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	f.Float64, err = strconv.ParseFloat(string(text), 64)
-	f.Valid = err == nil
+	f.Float64, f.Valid, err = scalar.UnmarshalTextPolicy[float64](text, p)
 	return err
 }
 
@@ -104,24 +80,7 @@ func (f Float64) MarshalJSON() ([]byte, error) {
 	if !f.Valid {
 		return []byte("null"), nil
 	}
-	if math.IsInf(f.Float64, 0) || math.IsNaN(f.Float64) {
-		return nil, &json.UnsupportedValueError{
-			Value: reflect.ValueOf(f.Float64),
-			Str:   strconv.FormatFloat(f.Float64, 'g', -1, 64),
-		}
-	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
-}
-
-func (f *Float64) SetBSON(raw bson.Raw) error {
-	return f.UnmarshalJSON(raw.Data)
-}
-
-func (f Float64) GetBSON() (interface{}, error) {
-	if !f.Valid {
-		return nil, nil
-	}
-	return f.Float64, nil
+	return scalar.MarshalJSON(f.Float64)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -130,7 +89,7 @@ func (f Float64) MarshalText() ([]byte, error) {
 	if !f.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatFloat(f.Float64, 'f', -1, 64)), nil
+	return []byte(scalar.Format(f.Float64)), nil
 }
 
 // SetValid changes this Float's value and also sets it to be non-null.
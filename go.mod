@@ -1,3 +1,10 @@
 module github.com/conneqtech/null
 
-require github.com/globalsign/mgo v0.0.0-20181015135952-eeefdecb41b8
+go 1.19
+
+require (
+	github.com/globalsign/mgo v0.0.0-20181015135952-eeefdecb41b8
+	go.mongodb.org/mongo-driver v1.17.4
+)
+
+require gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Int16 is a nullable int16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Int16 struct {
 	Int16 int16
 	Valid bool // Valid is true if Int16 is not NULL
 }
 
+// NewInt16 creates a new Int16
 func NewInt16(i int16, valid bool) Int16 {
 	return Int16{
 		Int16: i,
@@ -20,12 +23,12 @@ func NewInt16(i int16, valid bool) Int16 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Int16From creates a new Int16 that will always be valid.
 func Int16From(i int16) Int16 {
 	return NewInt16(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Int16FromPtr creates a new Int16 that be null if i is nil.
 func Int16FromPtr(i *int16) Int16 {
 	if i == nil {
 		return NewInt16(0, false)
@@ -41,85 +44,75 @@ func (i Int16) ValueOrZero() int16 {
 	return i.Int16
 }
 
-func (i *Int16) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int16.
+func (i *Int16) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Int16)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseInt(str, 10, 16)
-		if err != nil {
-			i.Int16 = int16(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Int16)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Int16, i.Valid, err = scalar.Scan[int16](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Int16) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Int16) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Int16) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Int16, nil
+	return scalar.DriverValue(i.Int16)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Int16.
+func (i *Int16) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int16) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Int16, i.Valid, err = scalar.UnmarshalJSONPolicy[int16](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Int16 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Int16) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int16) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseInt(str, 10, 16)
-	if err != nil {
-		i.Int16 = int16(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Int16, i.Valid, err = scalar.UnmarshalTextPolicy[int16](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int16 is null.
 func (i Int16) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int16), 10)), nil
+	return scalar.MarshalJSON(i.Int16)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int16 is null.
 func (i Int16) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int16), 10)), nil
+	return []byte(scalar.Format(i.Int16)), nil
 }
 
+// SetValid changes this Int16's value and also sets it to be non-null.
 func (i *Int16) SetValid(n int16) {
 	i.Int16 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
 func (i Int16) Ptr() *int16 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Int16) Ptr() *int16 {
 	return &i.Int16
 }
 
+// IsZero returns true for invalid Int16s, for future omitempty support.
+// A non-null Int16 with a 0 value will not be considered zero.
 func (i Int16) IsZero() bool {
 	return !i.Valid
 }
@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Int32 is a nullable int32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Int32 struct {
 	Int32 int32
 	Valid bool // Valid is true if Int32 is not NULL
 }
 
+// NewInt32 creates a new Int32
 func NewInt32(i int32, valid bool) Int32 {
 	return Int32{
 		Int32: i,
@@ -20,12 +23,12 @@ func NewInt32(i int32, valid bool) Int32 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Int32From creates a new Int32 that will always be valid.
 func Int32From(i int32) Int32 {
 	return NewInt32(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Int32FromPtr creates a new Int32 that be null if i is nil.
 func Int32FromPtr(i *int32) Int32 {
 	if i == nil {
 		return NewInt32(0, false)
@@ -41,85 +44,75 @@ func (i Int32) ValueOrZero() int32 {
 	return i.Int32
 }
 
-func (i *Int32) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int32.
+func (i *Int32) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Int32)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseInt(str, 10, 32)
-		if err != nil {
-			i.Int32 = int32(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Int32)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Int32, i.Valid, err = scalar.Scan[int32](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Int32) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Int32) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Int32) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Int32, nil
+	return scalar.DriverValue(i.Int32)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Int32.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int32) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Int32, i.Valid, err = scalar.UnmarshalJSONPolicy[int32](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Int32 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Int32) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int32) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseInt(str, 10, 32)
-	if err != nil {
-		i.Int32 = int32(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Int32, i.Valid, err = scalar.UnmarshalTextPolicy[int32](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int32 is null.
 func (i Int32) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int32), 10)), nil
+	return scalar.MarshalJSON(i.Int32)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int32 is null.
 func (i Int32) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int32), 10)), nil
+	return []byte(scalar.Format(i.Int32)), nil
 }
 
+// SetValid changes this Int32's value and also sets it to be non-null.
 func (i *Int32) SetValid(n int32) {
 	i.Int32 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
 func (i Int32) Ptr() *int32 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Int32) Ptr() *int32 {
 	return &i.Int32
 }
 
+// IsZero returns true for invalid Int32s, for future omitempty support.
+// A non-null Int32 with a 0 value will not be considered zero.
 func (i Int32) IsZero() bool {
 	return !i.Valid
 }
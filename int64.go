@@ -2,11 +2,9 @@ package null
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Int64 is an nullable int64.
@@ -16,7 +14,7 @@ type Int64 struct {
 	sql.NullInt64
 }
 
-// NewInt64 creates a new Int
+// NewInt64 creates a new Int64
 func NewInt64(i int64, valid bool) Int64 {
 	return Int64{
 		NullInt64: sql.NullInt64{
@@ -26,12 +24,12 @@ func NewInt64(i int64, valid bool) Int64 {
 	}
 }
 
-// IntFrom creates a new Int64 that will always be valid.
+// Int64From creates a new Int64 that will always be valid.
 func Int64From(i int64) Int64 {
 	return NewInt64(i, true)
 }
 
-// IntFromPtr creates a new Int64 that be null if i is nil.
+// Int64FromPtr creates a new Int64 that be null if i is nil.
 func Int64FromPtr(i *int64) Int64 {
 	if i == nil {
 		return NewInt64(0, false)
@@ -52,57 +50,27 @@ func (i Int64) ValueOrZero() int64 {
 // 0 will not be considered a null Int.
 // It also supports unmarshalling a sql.NullInt64.
 func (i *Int64) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Int64)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		i.Int64, err = strconv.ParseInt(str, 10, 64)
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.NullInt64)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
-	return err
-}
-
-func (s *Int64) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
+	return i.UnmarshalJSONWith(data, scalar.Default)
 }
 
-func (s Int64) GetBSON() (interface{}, error) {
-	if !s.Valid {
-		return nil, nil
-	}
-	return s.Int64, nil
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int64) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Int64, i.Valid, err = scalar.UnmarshalJSONPolicy[int64](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Int64 if the input is a blank or not an integer.
 // It will return an error if the input is not an integer, blank, or "null".
 func (i *Int64) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int64) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
-	i.Valid = err == nil
+	i.Int64, i.Valid, err = scalar.UnmarshalTextPolicy[int64](text, p)
 	return err
 }
 
@@ -112,7 +80,7 @@ func (i Int64) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return scalar.MarshalJSON(i.Int64)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -121,7 +89,7 @@ func (i Int64) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatInt(i.Int64, 10)), nil
+	return []byte(scalar.Format(i.Int64)), nil
 }
 
 // SetValid changes this Int's value and also sets it to be non-null.
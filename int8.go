@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Int8 is a nullable int8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Int8 struct {
 	Int8  int8
 	Valid bool // Valid is true if Int8 is not NULL
 }
 
+// NewInt8 creates a new Int8
 func NewInt8(i int8, valid bool) Int8 {
 	return Int8{
 		Int8:  i,
@@ -20,12 +23,12 @@ func NewInt8(i int8, valid bool) Int8 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Int8From creates a new Int8 that will always be valid.
 func Int8From(i int8) Int8 {
 	return NewInt8(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Int8FromPtr creates a new Int8 that be null if i is nil.
 func Int8FromPtr(i *int8) Int8 {
 	if i == nil {
 		return NewInt8(0, false)
@@ -41,85 +44,75 @@ func (i Int8) ValueOrZero() int8 {
 	return i.Int8
 }
 
-func (i *Int8) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int8.
+func (i *Int8) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Int8)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseInt(str, 10, 8)
-		if err != nil {
-			i.Int8 = int8(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Int8)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Int8, i.Valid, err = scalar.Scan[int8](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Int8) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Int8) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Int8) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Int8, nil
+	return scalar.DriverValue(i.Int8)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int8) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Int8, i.Valid, err = scalar.UnmarshalJSONPolicy[int8](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Int8 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Int8) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int8) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseInt(str, 10, 8)
-	if err != nil {
-		i.Int8 = int8(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Int8, i.Valid, err = scalar.UnmarshalTextPolicy[int8](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Int8 is null.
 func (i Int8) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int8), 10)), nil
+	return scalar.MarshalJSON(i.Int8)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Int8 is null.
 func (i Int8) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Int8), 10)), nil
+	return []byte(scalar.Format(i.Int8)), nil
 }
 
+// SetValid changes this Int8's value and also sets it to be non-null.
 func (i *Int8) SetValid(n int8) {
 	i.Int8 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
 func (i Int8) Ptr() *int8 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Int8) Ptr() *int8 {
 	return &i.Int8
 }
 
+// IsZero returns true for invalid Int8s, for future omitempty support.
+// A non-null Int8 with a 0 value will not be considered zero.
 func (i Int8) IsZero() bool {
 	return !i.Valid
 }
@@ -0,0 +1,174 @@
+package scalar
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/conneqtech/null/decimal"
+)
+
+// numberLiteral matches a base 10 number with an optional fraction and
+// exponent, such as JSON allows. big.Rat also accepts fractions such as
+// "1/3" and base prefixes such as "0x10", which are not numbers here.
+var numberLiteral = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+// parseBigInt parses a base 10 integer into n. It may be written with a
+// fraction or an exponent, such as "1.0" or "1e3", if its value is an integer.
+// The exponent is bounded by decimal.MaxExponent.
+func parseBigInt(n *big.Int, s string) bool {
+	if _, ok := n.SetString(s, 10); ok {
+		return true
+	}
+	if !numberLiteral.MatchString(s) {
+		return false
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		if e, err := strconv.Atoi(s[i+1:]); err != nil || e > decimal.MaxExponent || e < -decimal.MaxExponent {
+			return false
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || !r.IsInt() {
+		return false
+	}
+	n.Set(r.Num())
+	return true
+}
+
+// ScanBigInt converts a value read from a database driver into a big.Int.
+// NUMERIC columns are usually read as a string or []byte.
+// Floats are accepted only if they hold an integer.
+func ScanBigInt(src interface{}, typ reflect.Type) (n *big.Int, valid bool, err error) {
+	n = new(big.Int)
+	switch x := normalize(src).(type) {
+	case nil:
+		return n, false, nil
+	case []byte, string:
+		if !parseBigInt(n, asString(x)) {
+			return n, false, scanError(src, typ, nil)
+		}
+	case int64:
+		n.SetInt64(x)
+	case uint64:
+		n.SetUint64(x)
+	case float64:
+		f := big.NewFloat(x)
+		if !f.IsInt() {
+			return n, false, rangeError(src, typ)
+		}
+		f.Int(n)
+	default:
+		return n, false, scanError(src, typ, nil)
+	}
+	return n, true, nil
+}
+
+// BigIntDriverValue converts n into a driver.Value: an int64 if it fits,
+// and its decimal string otherwise, which NUMERIC columns accept.
+func BigIntDriverValue(n *big.Int) driver.Value {
+	if n.IsInt64() {
+		return n.Int64()
+	}
+	return n.String()
+}
+
+// UnmarshalBigIntJSON decodes a JSON integer, or a string if p allows
+// number strings, into a big.Int without going through a float64.
+// Numbers with a fraction or exponent are accepted if they are integers.
+// valid is false for JSON null. typ is the destination type reported in errors.
+func UnmarshalBigIntJSON(data []byte, typ reflect.Type, p Policy) (n *big.Int, valid bool, err error) {
+	n = new(big.Int)
+	var x interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&x); err != nil {
+		return n, false, err
+	}
+	switch x := x.(type) {
+	case json.Number:
+		if !parseBigInt(n, string(x)) {
+			return n, false, typeError("number "+string(x), typ)
+		}
+		return n, true, nil
+	case string:
+		switch {
+		case x == "" && p.EmptyStringNull:
+			return n, false, nil
+		case p.NumberStrings:
+			if !parseBigInt(n, x) {
+				return n, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			return n, true, nil
+		default:
+			return n, false, typeError("string", typ)
+		}
+	case bool:
+		return n, false, typeError("bool", typ)
+	case nil:
+		return n, false, nil
+	case map[string]interface{}:
+		return n, false, typeError("object", typ)
+	default:
+		return n, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalBigIntText decodes base 10 text into a big.Int.
+// valid is false for blank input and "null".
+func UnmarshalBigIntText(text []byte) (n *big.Int, valid bool, err error) {
+	n = new(big.Int)
+	str := string(text)
+	if str == "" || str == "null" {
+		return n, false, nil
+	}
+	if !parseBigInt(n, str) {
+		return n, false, fmt.Errorf("invalid input:%s", str)
+	}
+	return n, true, nil
+}
+
+// DecodeBSONBigInt decodes the raw bytes of a BSON int32, int64, double
+// or decimal128 holding an integer into a big.Int.
+// valid is false for BSON null and undefined.
+func DecodeBSONBigInt(kind byte, data []byte, typ reflect.Type) (n *big.Int, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return new(big.Int), false, nil
+	case BSONInt32, BSONInt64, BSONDouble:
+		src, err := readBSONNumber(kind, data, reflect.Float64, typ)
+		if err != nil {
+			return new(big.Int), false, err
+		}
+		return ScanBigInt(src, typ)
+	case BSONDecimal128:
+		s, err := DecodeDecimal128(data)
+		if err != nil {
+			return new(big.Int), false, err
+		}
+		r, ok := new(big.Rat).SetString(s)
+		if !ok || !r.IsInt() {
+			return new(big.Int), false, fmt.Errorf("null: decimal128 value %s is not an integer and cannot be decoded into %v", s, typ)
+		}
+		return new(big.Int).Set(r.Num()), true, nil
+	default:
+		return new(big.Int), false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONBigInt returns the BSON kind and raw bytes that represent n:
+// an int64 if it fits, and a decimal128 otherwise.
+// It returns an error if n has more than 34 digits.
+func EncodeBSONBigInt(n *big.Int) (kind byte, data []byte, err error) {
+	if n.IsInt64() {
+		return EncodeBSONValue(n.Int64())
+	}
+	data, err = EncodeDecimal128(n, 0)
+	return BSONDecimal128, data, err
+}
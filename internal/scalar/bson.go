@@ -0,0 +1,32 @@
+//go:build mgo
+
+package scalar
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+// DecodeBSON decodes an mgo raw BSON value into a T according to its kind.
+// See DecodeBSONValue for the conversion rules.
+func DecodeBSON[T Scalar](raw bson.Raw, typ reflect.Type) (T, bool, error) {
+	return DecodeBSONValue[T](raw.Kind, raw.Data, typ)
+}
+
+// DecodeBSONTime decodes an mgo raw BSON datetime.
+// valid is false for BSON null and undefined.
+func DecodeBSONTime(raw bson.Raw, typ reflect.Type) (time.Time, bool, error) {
+	return DecodeBSONTimeValue(raw.Kind, raw.Data, typ)
+}
+
+// EncodeBSON returns the mgo raw BSON value that represents v.
+// See EncodeBSONValue for the choice of kind.
+func EncodeBSON[T Scalar](v T) (interface{}, error) {
+	kind, data, err := EncodeBSONValue(v)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
@@ -0,0 +1,35 @@
+//go:build mgo
+
+package scalar
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+func TestMgoDecimal128(t *testing.T) {
+	for _, in := range []string{"0", "1.5", "-1234.5678", "1.2E+30"} {
+		d, err := bson.ParseDecimal128(in)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var raw struct{ D bson.Raw }
+		doc, err := bson.Marshal(bson.M{"d": d})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = bson.Unmarshal(doc, &raw); err != nil {
+			t.Fatal(err)
+		}
+		s, err := DecodeDecimal128(raw.D.Data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := new(big.Rat).SetString(in)
+		if got, ok := new(big.Rat).SetString(s); !ok || got.Cmp(want) != 0 {
+			t.Errorf("mgo decimal128 %s: decoded %s", in, s)
+		}
+	}
+}
@@ -0,0 +1,281 @@
+package scalar
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// BSON element kinds. Both the mgo and the official driver encodings
+// describe a value as one of these kinds followed by its raw bytes.
+const (
+	BSONDouble     byte = 0x01
+	BSONString     byte = 0x02
+	BSONDocument   byte = 0x03
+	BSONArray      byte = 0x04
+	BSONBinary     byte = 0x05
+	BSONUndefined  byte = 0x06
+	BSONObjectID   byte = 0x07
+	BSONBool       byte = 0x08
+	BSONDatetime   byte = 0x09
+	BSONNull       byte = 0x0A
+	BSONRegex      byte = 0x0B
+	BSONInt32      byte = 0x10
+	BSONTimestamp  byte = 0x11
+	BSONInt64      byte = 0x12
+	BSONDecimal128 byte = 0x13
+)
+
+var bsonKindNames = map[byte]string{
+	BSONDouble:     "double",
+	BSONString:     "string",
+	BSONDocument:   "document",
+	BSONArray:      "array",
+	BSONBinary:     "binary",
+	BSONUndefined:  "undefined",
+	BSONObjectID:   "objectId",
+	BSONBool:       "bool",
+	BSONDatetime:   "datetime",
+	BSONNull:       "null",
+	BSONRegex:      "regex",
+	BSONInt32:      "int32",
+	BSONTimestamp:  "timestamp",
+	BSONInt64:      "int64",
+	BSONDecimal128: "decimal128",
+}
+
+// zeroTimeMillis is time.Time{} in Unix milliseconds. It is decoded
+// back to time.Time{} rather than a UTC instant, as mgo does.
+const zeroTimeMillis = -62135596800000
+
+var errBSONLength = errors.New("null: BSON value has an invalid length")
+
+// DecodeBSONValue decodes the raw bytes of a BSON value of the given kind
+// into a T. Numeric kinds (int32, int64, double and decimal128) convert
+// into any numeric T with the same range checks as Scan. Strings only
+// decode into string types and bools only into bool types. valid is false
+// for BSON null and undefined. typ is the destination type reported in errors.
+func DecodeBSONValue[T Scalar](kind byte, data []byte, typ reflect.Type) (v T, valid bool, err error) {
+	var src interface{}
+	goKind := reflect.ValueOf(v).Kind()
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return v, false, nil
+	case BSONString:
+		if goKind != reflect.String {
+			return v, false, KindError(kind, typ)
+		}
+		if src, err = readBSONString(data); err != nil {
+			return v, false, err
+		}
+	case BSONBool:
+		if goKind != reflect.Bool {
+			return v, false, KindError(kind, typ)
+		}
+		if len(data) != 1 {
+			return v, false, errBSONLength
+		}
+		src = data[0] != 0
+	case BSONInt32, BSONInt64, BSONDouble, BSONDecimal128:
+		if goKind == reflect.String || goKind == reflect.Bool {
+			return v, false, KindError(kind, typ)
+		}
+		if src, err = readBSONNumber(kind, data, goKind, typ); err != nil {
+			return v, false, err
+		}
+	default:
+		return v, false, KindError(kind, typ)
+	}
+	return Scan[T](src, typ)
+}
+
+// EncodeBSONValue returns the BSON kind and raw bytes that represent v.
+// Signed and unsigned integers narrower than 32 bits use int32 and the
+// rest use int64. BSON has no unsigned integers, so unsigned values above
+// math.MaxInt64 are stored as decimal128 rather than overflowing.
+func EncodeBSONValue[T Scalar](v T) (kind byte, data []byte, err error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return BSONInt32, binary.LittleEndian.AppendUint32(nil, uint32(rv.Int())), nil
+	case reflect.Int, reflect.Int64:
+		return BSONInt64, binary.LittleEndian.AppendUint64(nil, uint64(rv.Int())), nil
+	case reflect.Uint8, reflect.Uint16:
+		return BSONInt32, binary.LittleEndian.AppendUint32(nil, uint32(rv.Uint())), nil
+	case reflect.Uint, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			data, err = EncodeDecimal128(new(big.Int).SetUint64(u), 0)
+			return BSONDecimal128, data, err
+		}
+		return BSONInt64, binary.LittleEndian.AppendUint64(nil, u), nil
+	case reflect.Float32, reflect.Float64:
+		return BSONDouble, binary.LittleEndian.AppendUint64(nil, math.Float64bits(rv.Float())), nil
+	case reflect.Bool:
+		if rv.Bool() {
+			return BSONBool, []byte{1}, nil
+		}
+		return BSONBool, []byte{0}, nil
+	default:
+		return BSONString, AppendBSONString(nil, rv.String()), nil
+	}
+}
+
+// DecodeBSONTimeValue decodes the raw bytes of a BSON datetime.
+// valid is false for BSON null and undefined.
+func DecodeBSONTimeValue(kind byte, data []byte, typ reflect.Type) (t time.Time, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return t, false, nil
+	case BSONDatetime:
+		if len(data) != 8 {
+			return t, false, errBSONLength
+		}
+		ms := int64(binary.LittleEndian.Uint64(data))
+		if ms == zeroTimeMillis {
+			return time.Time{}, true, nil
+		}
+		return time.Unix(ms/1e3, ms%1e3*1e6).UTC(), true, nil
+	default:
+		return t, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONTime returns the raw bytes of t as a BSON datetime,
+// which has millisecond precision.
+func EncodeBSONTime(t time.Time) (kind byte, data []byte) {
+	ms := t.Unix()*1e3 + int64(t.Nanosecond()/1e6)
+	return BSONDatetime, binary.LittleEndian.AppendUint64(nil, uint64(ms))
+}
+
+// AppendBSONString appends s encoded as a BSON string value.
+func AppendBSONString(dst []byte, s string) []byte {
+	dst = binary.LittleEndian.AppendUint32(dst, uint32(len(s)+1))
+	dst = append(dst, s...)
+	return append(dst, 0)
+}
+
+func readBSONString(data []byte) (string, error) {
+	if len(data) < 5 {
+		return "", errBSONLength
+	}
+	n := int(binary.LittleEndian.Uint32(data))
+	if n != len(data)-4 || data[len(data)-1] != 0 {
+		return "", errBSONLength
+	}
+	return string(data[4 : len(data)-1]), nil
+}
+
+// readBSONNumber reads a numeric BSON value as the int64, uint64 or
+// float64 that Scan converts into the destination kind.
+func readBSONNumber(kind byte, data []byte, goKind reflect.Kind, typ reflect.Type) (interface{}, error) {
+	switch kind {
+	case BSONInt32:
+		if len(data) != 4 {
+			return nil, errBSONLength
+		}
+		return int64(int32(binary.LittleEndian.Uint32(data))), nil
+	case BSONInt64:
+		if len(data) != 8 {
+			return nil, errBSONLength
+		}
+		return int64(binary.LittleEndian.Uint64(data)), nil
+	case BSONDouble:
+		if len(data) != 8 {
+			return nil, errBSONLength
+		}
+		return math.Float64frombits(binary.LittleEndian.Uint64(data)), nil
+	}
+	s, err := DecodeDecimal128(data)
+	if err != nil {
+		return nil, err
+	}
+	if goKind == reflect.Float32 || goKind == reflect.Float64 {
+		return strconv.ParseFloat(s, 64)
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok || !r.IsInt() {
+		return nil, fmt.Errorf("null: decimal128 value %s is not an integer and cannot be decoded into %v", s, typ)
+	}
+	switch n := r.Num(); {
+	case n.IsInt64():
+		return n.Int64(), nil
+	case n.IsUint64():
+		return n.Uint64(), nil
+	default:
+		return nil, fmt.Errorf("null: decimal128 value %s is out of range for %v", s, typ)
+	}
+}
+
+// DecodeDecimal128 returns the decimal string form of a BSON decimal128,
+// such as "-1234E-2", "NaN", "Inf" or "-Inf". The form is accepted by
+// strconv.ParseFloat and big.Rat.SetString.
+func DecodeDecimal128(data []byte) (string, error) {
+	if len(data) != 16 {
+		return "", errBSONLength
+	}
+	lo := binary.LittleEndian.Uint64(data[:8])
+	hi := binary.LittleEndian.Uint64(data[8:])
+	sign := ""
+	if hi>>63 == 1 {
+		sign = "-"
+	}
+	switch (hi >> 58) & 0x1F {
+	case 0x1F:
+		return "NaN", nil
+	case 0x1E:
+		return sign + "Inf", nil
+	}
+	var exp int
+	coef := new(big.Int)
+	if (hi>>61)&3 == 3 {
+		// The coefficient would exceed the 34 digit maximum,
+		// which the specification defines as zero.
+		exp = int((hi >> 47) & 0x3FFF)
+	} else {
+		exp = int((hi >> 49) & 0x3FFF)
+		coef.SetUint64(hi & (1<<49 - 1))
+		coef.Lsh(coef, 64)
+		coef.Or(coef, new(big.Int).SetUint64(lo))
+	}
+	return sign + coef.String() + "E" + strconv.Itoa(exp-decimal128Bias), nil
+}
+
+const (
+	decimal128Bias   = 6176
+	decimal128MaxExp = 6111
+	decimal128MinExp = -6176
+)
+
+var decimal128MaxCoef = new(big.Int).Exp(big.NewInt(10), big.NewInt(34), nil)
+
+// EncodeDecimal128 returns the BSON decimal128 bytes of coef × 10^exp.
+// coef may be negative, but must have at most 34 digits.
+func EncodeDecimal128(coef *big.Int, exp int) ([]byte, error) {
+	abs := new(big.Int).Abs(coef)
+	if abs.Cmp(decimal128MaxCoef) >= 0 || exp < decimal128MinExp || exp > decimal128MaxExp {
+		return nil, fmt.Errorf("null: %vE%d cannot be represented as a decimal128", coef, exp)
+	}
+	lo := new(big.Int).And(abs, new(big.Int).SetUint64(math.MaxUint64)).Uint64()
+	hi := new(big.Int).Rsh(abs, 64).Uint64()
+	hi |= uint64(exp+decimal128Bias) << 49
+	if coef.Sign() < 0 {
+		hi |= 1 << 63
+	}
+	data := binary.LittleEndian.AppendUint64(nil, lo)
+	return binary.LittleEndian.AppendUint64(data, hi), nil
+}
+
+// KindError reports a BSON value whose kind cannot be decoded into typ.
+func KindError(kind byte, typ reflect.Type) error {
+	name, ok := bsonKindNames[kind]
+	if !ok {
+		name = fmt.Sprintf("kind 0x%02x", kind)
+	}
+	return fmt.Errorf("null: cannot decode BSON %s into %v", name, typ)
+}
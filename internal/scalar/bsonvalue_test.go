@@ -0,0 +1,48 @@
+package scalar
+
+import (
+	"encoding/binary"
+	"math/big"
+	"testing"
+)
+
+func TestDecimal128(t *testing.T) {
+	tests := []struct {
+		in   string
+		coef int64
+		exp  int
+	}{
+		{"0", 0, 0},
+		{"1.5", 15, -1},
+		{"-1234.5678", -12345678, -4},
+		{"1.2E+30", 12, 29},
+	}
+	for _, tc := range tests {
+		ours, err := EncodeDecimal128(big.NewInt(tc.coef), tc.exp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := DecodeDecimal128(ours)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, _ := new(big.Rat).SetString(tc.in)
+		got, ok := new(big.Rat).SetString(s)
+		if !ok || got.Cmp(want) != 0 {
+			t.Errorf("decimal128 %s: decoded %s", tc.in, s)
+		}
+	}
+
+	// 1.5 is the coefficient 15 with the biased exponent 6175.
+	data, err := EncodeDecimal128(big.NewInt(15), -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lo, hi := binary.LittleEndian.Uint64(data[:8]), binary.LittleEndian.Uint64(data[8:]); lo != 15 || hi != 6175<<49 {
+		t.Errorf("decimal128 1.5: unexpected bits %#x %#x", hi, lo)
+	}
+
+	if _, err := EncodeDecimal128(new(big.Int).Exp(big.NewInt(10), big.NewInt(34), nil), 0); err == nil {
+		t.Error("expected error for a 35 digit coefficient")
+	}
+}
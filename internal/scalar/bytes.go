@@ -0,0 +1,137 @@
+package scalar
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// BytesEncoding is how a byte slice is written as JSON or text.
+type BytesEncoding interface {
+	EncodeToString(src []byte) string
+	DecodeString(s string) ([]byte, error)
+}
+
+var (
+	// Base64 is standard padded base64, which encoding/json uses for []byte.
+	Base64 BytesEncoding = base64.StdEncoding
+	// Base64URL is unpadded URL-safe base64. Padded input is accepted too.
+	Base64URL BytesEncoding = base64URL{}
+	// Hex is lower case hexadecimal. Upper case input is accepted too.
+	Hex BytesEncoding = hexEncoding{}
+)
+
+type base64URL struct{}
+
+func (base64URL) EncodeToString(src []byte) string {
+	return base64.RawURLEncoding.EncodeToString(src)
+}
+
+func (base64URL) DecodeString(s string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+}
+
+type hexEncoding struct{}
+
+func (hexEncoding) EncodeToString(src []byte) string {
+	return hex.EncodeToString(src)
+}
+
+func (hexEncoding) DecodeString(s string) ([]byte, error) {
+	return hex.DecodeString(s)
+}
+
+// ScanBytes converts a value read from a database driver into a byte slice.
+// The bytes are always copied, because drivers may reuse their buffer
+// (as with sql.RawBytes) once the next row is read.
+// An empty column scans as a valid empty slice, not nil.
+func ScanBytes(src interface{}, typ reflect.Type) (b []byte, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return nil, false, nil
+	case []byte:
+		return append(make([]byte, 0, len(x)), x...), true, nil
+	case string:
+		return []byte(x), true, nil
+	default:
+		return nil, false, scanError(src, typ, nil)
+	}
+}
+
+// MarshalBytesJSON returns b as a JSON string in the given encoding.
+func MarshalBytesJSON(b []byte, enc BytesEncoding) ([]byte, error) {
+	return json.Marshal(enc.EncodeToString(b))
+}
+
+// UnmarshalBytesJSON decodes a JSON string in the given encoding.
+// valid is false for JSON null, but a blank string is a valid empty slice.
+// typ is the destination type reported in errors.
+func UnmarshalBytesJSON(data []byte, typ reflect.Type, enc BytesEncoding) (b []byte, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return nil, false, err
+	}
+	switch x := x.(type) {
+	case string:
+		if b, err = enc.DecodeString(x); err != nil {
+			return nil, false, err
+		}
+		return append([]byte{}, b...), true, nil
+	case nil:
+		return nil, false, nil
+	case float64:
+		return nil, false, typeError("number", typ)
+	case bool:
+		return nil, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return nil, false, typeError("object", typ)
+	default:
+		return nil, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalBytesText decodes text in the given encoding.
+// Blank input is a valid empty slice, as it is in JSON.
+func UnmarshalBytesText(text []byte, enc BytesEncoding) (b []byte, valid bool, err error) {
+	if len(text) == 0 {
+		return []byte{}, true, nil
+	}
+	if b, err = enc.DecodeString(string(text)); err != nil {
+		return nil, false, err
+	}
+	return b, true, nil
+}
+
+// DecodeBSONBytes decodes the raw bytes of a BSON binary value of any subtype.
+// valid is false for BSON null and undefined.
+func DecodeBSONBytes(kind byte, data []byte, typ reflect.Type) (b []byte, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return nil, false, nil
+	case BSONBinary:
+		if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data)-5 {
+			return nil, false, errBSONLength
+		}
+		b = data[5:]
+		if data[4] == 0x02 {
+			// The old binary subtype repeats the length inside the payload.
+			if len(b) < 4 || int(binary.LittleEndian.Uint32(b)) != len(b)-4 {
+				return nil, false, errBSONLength
+			}
+			b = b[4:]
+		}
+		return append([]byte{}, b...), true, nil
+	default:
+		return nil, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONBytes returns b as the raw bytes of a generic BSON binary value.
+func EncodeBSONBytes(b []byte) (kind byte, data []byte) {
+	data = binary.LittleEndian.AppendUint32(nil, uint32(len(b)))
+	data = append(data, 0x00)
+	return BSONBinary, append(data, b...)
+}
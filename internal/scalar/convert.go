@@ -0,0 +1,78 @@
+package scalar
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+)
+
+// Convert converts src into D by scanning its driver value,
+// and returns an error if the result does not represent it exactly.
+func Convert[D any, PD interface {
+	*D
+	sql.Scanner
+	driver.Valuer
+}](src driver.Valuer) (D, error) {
+	var dst D
+	v, err := src.Value()
+	if err != nil || v == nil {
+		return dst, err
+	}
+	if err = PD(&dst).Scan(v); err != nil {
+		return dst, err
+	}
+	back, err := PD(&dst).Value()
+	if err != nil {
+		return dst, err
+	}
+	if !Lossless(v, back) {
+		var zero D
+		return zero, fmt.Errorf("null: converting %T value %v to %v loses precision", src, v, reflect.TypeOf(dst))
+	}
+	return dst, nil
+}
+
+// Lossless reports whether dst, the driver value of a conversion of src,
+// represents exactly the same number, bool or string as src.
+// A nil dst is a zero value dropped by a zero type, and is always lossless.
+func Lossless(src, dst driver.Value) bool {
+	if dst == nil {
+		return true
+	}
+	if a, ok := src.(float64); ok && (math.IsInf(a, 0) || math.IsNaN(a)) {
+		b, ok := dst.(float64)
+		return ok && (a == b || math.IsNaN(a) && math.IsNaN(b))
+	}
+	if a, ok := src.(bool); ok {
+		b, ok := dst.(bool)
+		return ok && a == b
+	}
+	if a, ok := src.(string); ok {
+		if b, ok := dst.(string); ok {
+			return a == b
+		}
+	}
+	a, b := toRat(src), toRat(dst)
+	return a != nil && b != nil && a.Cmp(b) == 0
+}
+
+// toRat returns the exact value of a numeric driver value,
+// or nil if it is not a finite number.
+func toRat(v driver.Value) *big.Rat {
+	switch x := v.(type) {
+	case int64:
+		return new(big.Rat).SetInt64(x)
+	case float64:
+		return new(big.Rat).SetFloat64(x)
+	case string:
+		r, ok := new(big.Rat).SetString(x)
+		if !ok {
+			return nil
+		}
+		return r
+	}
+	return nil
+}
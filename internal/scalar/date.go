@@ -0,0 +1,128 @@
+package scalar
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+// ScanDate converts a value read from a database driver into a Date.
+// A time.Time is read on its own wall clock, as drivers return DATE
+// columns at midnight. Strings and []byte values hold "2006-01-02",
+// optionally followed by a time of day, as text protocols return them.
+func ScanDate(src interface{}, typ reflect.Type) (d civil.Date, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return d, false, nil
+	case time.Time:
+		return civil.DateOf(x), true, nil
+	case []byte:
+		d, err = parseDatePrefix(string(x))
+	case string:
+		d, err = parseDatePrefix(x)
+	default:
+		return d, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		// The civil error repeats the input, which scanError reports.
+		return d, false, scanError(src, typ, errDate)
+	}
+	return d, true, nil
+}
+
+var errDate = errors.New(`invalid date, want "2006-01-02" with an optional time of day`)
+
+// parseDatePrefix parses a date that may be followed by a time of day,
+// such as "2006-01-02 15:04:05", ignoring the time.
+func parseDatePrefix(s string) (civil.Date, error) {
+	n := len(civil.DateLayout)
+	if len(s) > n && (s[n] == ' ' || s[n] == 'T') {
+		s = s[:n]
+	}
+	return civil.ParseDate(s)
+}
+
+// UnmarshalDateJSON decodes a JSON string in "2006-01-02" form.
+// valid is false for JSON null, and for a blank string if p allows it.
+// typ is the destination type reported in errors.
+func UnmarshalDateJSON(data []byte, typ reflect.Type, p Policy) (d civil.Date, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return d, false, err
+	}
+	switch x := x.(type) {
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return d, false, nil
+		}
+		if d, err = civil.ParseDate(x); err != nil {
+			return d, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return d, true, nil
+	case nil:
+		return d, false, nil
+	case float64:
+		return d, false, typeError("number", typ)
+	case bool:
+		return d, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return d, false, typeError("object", typ)
+	default:
+		return d, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalDateText decodes a date in "2006-01-02" form.
+// valid is false for blank input and "null".
+func UnmarshalDateText(text []byte) (d civil.Date, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return d, false, nil
+	}
+	if d, err = civil.ParseDate(str); err != nil {
+		return d, false, err
+	}
+	return d, true, nil
+}
+
+// MarshalDateJSON returns d as a JSON string in "2006-01-02" form.
+func MarshalDateJSON(d civil.Date) []byte {
+	return []byte(`"` + d.String() + `"`)
+}
+
+// DecodeBSONDate decodes the raw bytes of a BSON datetime, read in UTC,
+// or a string in "2006-01-02" form.
+// valid is false for BSON null and undefined.
+func DecodeBSONDate(kind byte, data []byte, typ reflect.Type) (d civil.Date, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return d, false, nil
+	case BSONDatetime:
+		t, _, err := DecodeBSONTimeValue(kind, data, typ)
+		if err != nil {
+			return d, false, err
+		}
+		return civil.DateOf(t.UTC()), true, nil
+	case BSONString:
+		s, err := readBSONString(data)
+		if err != nil {
+			return d, false, err
+		}
+		if d, err = parseDatePrefix(s); err != nil {
+			return d, false, err
+		}
+		return d, true, nil
+	default:
+		return d, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONDate returns d as the raw bytes of a BSON datetime at
+// midnight UTC, since BSON has no date type.
+func EncodeBSONDate(d civil.Date) (kind byte, data []byte) {
+	return EncodeBSONTime(d.In(time.UTC))
+}
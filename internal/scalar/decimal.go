@@ -0,0 +1,121 @@
+package scalar
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/conneqtech/null/decimal"
+)
+
+// ScanDecimal converts a value read from a database driver into a Decimal.
+// NUMERIC columns are usually read as a string or []byte.
+func ScanDecimal(src interface{}, typ reflect.Type) (d decimal.Decimal, valid bool, err error) {
+	switch x := normalize(src).(type) {
+	case nil:
+		return d, false, nil
+	case decimal.Decimal:
+		return x, true, nil
+	case []byte, string:
+		d, err = decimal.Parse(asString(x))
+	case int64:
+		d = decimal.New(x, 0)
+	case uint64:
+		d, err = decimal.Parse(strconv.FormatUint(x, 10))
+	case float64:
+		d, err = decimal.NewFromFloat(x)
+	default:
+		return d, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		return d, false, scanError(src, typ, err)
+	}
+	return d, true, nil
+}
+
+// UnmarshalDecimalJSON decodes a JSON number, or a string if p allows
+// number strings, into a Decimal without going through a float64.
+// valid is false for JSON null. typ is the destination type reported in errors.
+func UnmarshalDecimalJSON(data []byte, typ reflect.Type, p Policy) (d decimal.Decimal, valid bool, err error) {
+	var x interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&x); err != nil {
+		return d, false, err
+	}
+	switch x := x.(type) {
+	case json.Number:
+		if d, err = decimal.Parse(string(x)); err != nil {
+			return d, false, typeError("number "+string(x), typ)
+		}
+		return d, true, nil
+	case string:
+		switch {
+		case x == "" && p.EmptyStringNull:
+			return d, false, nil
+		case p.NumberStrings:
+			if d, err = decimal.Parse(x); err != nil {
+				return d, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			return d, true, nil
+		default:
+			return d, false, typeError("string", typ)
+		}
+	case bool:
+		return d, false, typeError("bool", typ)
+	case nil:
+		return d, false, nil
+	case map[string]interface{}:
+		return d, false, typeError("object", typ)
+	default:
+		return d, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalDecimalText decodes text into a Decimal.
+// valid is false for blank input and "null".
+func UnmarshalDecimalText(text []byte) (d decimal.Decimal, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return d, false, nil
+	}
+	if d, err = decimal.Parse(str); err != nil {
+		return d, false, err
+	}
+	return d, true, nil
+}
+
+// DecodeBSONDecimal decodes the raw bytes of a BSON decimal128, int32,
+// int64 or double into a Decimal. valid is false for BSON null and undefined.
+func DecodeBSONDecimal(kind byte, data []byte, typ reflect.Type) (d decimal.Decimal, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return d, false, nil
+	case BSONInt32, BSONInt64, BSONDouble:
+		src, err := readBSONNumber(kind, data, reflect.Float64, typ)
+		if err != nil {
+			return d, false, err
+		}
+		return ScanDecimal(src, typ)
+	case BSONDecimal128:
+		s, err := DecodeDecimal128(data)
+		if err != nil {
+			return d, false, err
+		}
+		if d, err = decimal.Parse(s); err != nil {
+			return d, false, fmt.Errorf("null: cannot decode BSON decimal128 %s into %v", s, typ)
+		}
+		return d, true, nil
+	default:
+		return d, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONDecimal returns d as the raw bytes of a BSON decimal128.
+// It returns an error if d has more than 34 significant digits.
+func EncodeBSONDecimal(d decimal.Decimal) (kind byte, data []byte, err error) {
+	data, err = EncodeDecimal128(d.Coefficient(), d.Exponent())
+	return BSONDecimal128, data, err
+}
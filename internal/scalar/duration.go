@@ -0,0 +1,369 @@
+package scalar
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDuration parses a duration in any of the forms that Duration accepts:
+// a Go duration ("1h30m"), an ISO 8601 duration ("PT1H30M" or "P1DT2H"),
+// or a Postgres interval in its default output style ("1 day 02:30:00").
+// Days are 24 hours and weeks are 7 days. Years and months have no fixed
+// length, so they are rejected.
+func ParseDuration(s string) (time.Duration, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, nil
+	}
+	if d, ok, err := parseISODuration(s); ok {
+		return d, err
+	}
+	if d, ok, err := parseIntervalDuration(s); ok {
+		return d, err
+	}
+	return 0, errDuration
+}
+
+// Errors from parsing durations leave out the input; callers report it.
+var (
+	errDuration       = errors.New("invalid duration, want a Go or ISO 8601 duration or a Postgres interval")
+	errDurationMonths = errors.New("duration has years or months, which have no fixed length")
+	errDurationRange  = errors.New("duration is out of range")
+)
+
+var isoDuration = regexp.MustCompile(`^([+-])?P(?:([0-9]+(?:[.,][0-9]+)?)Y)?(?:([0-9]+(?:[.,][0-9]+)?)M)?(?:([0-9]+(?:[.,][0-9]+)?)W)?(?:([0-9]+(?:[.,][0-9]+)?)D)?(?:T(?:([0-9]+(?:[.,][0-9]+)?)H)?(?:([0-9]+(?:[.,][0-9]+)?)M)?(?:([0-9]+(?:[.,][0-9]+)?)S)?)?$`)
+
+var isoUnits = [...]time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+// parseISODuration parses an ISO 8601 duration. ok is false if s is not one.
+func parseISODuration(s string) (d time.Duration, ok bool, err error) {
+	m := isoDuration.FindStringSubmatch(s)
+	if m == nil || strings.HasSuffix(s, "P") || strings.HasSuffix(s, "T") {
+		return 0, false, nil
+	}
+	if m[2] != "" || m[3] != "" {
+		return 0, true, errDurationMonths
+	}
+	total := new(big.Rat)
+	for i, unit := range isoUnits {
+		if m[i+4] == "" {
+			continue
+		}
+		n, ok := new(big.Rat).SetString(strings.Replace(m[i+4], ",", ".", 1))
+		if !ok {
+			return 0, true, errDuration
+		}
+		total.Add(total, n.Mul(n, new(big.Rat).SetInt64(int64(unit))))
+	}
+	if m[1] == "-" {
+		total.Neg(total)
+	}
+	d, err = ratDuration(total)
+	return d, true, err
+}
+
+// intervalNumber matches the numbers of a Postgres interval. big.Rat also
+// accepts fractions such as "1/3" and base prefixes such as "0x10".
+var intervalNumber = regexp.MustCompile(`^[+-]?[0-9]+(\.[0-9]+)?$`)
+
+var intervalUnits = map[string]time.Duration{
+	"week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+	"day": 24 * time.Hour, "days": 24 * time.Hour,
+	"hour": time.Hour, "hours": time.Hour,
+	"min": time.Minute, "mins": time.Minute, "minute": time.Minute, "minutes": time.Minute,
+	"sec": time.Second, "secs": time.Second, "second": time.Second, "seconds": time.Second,
+}
+
+// parseIntervalDuration parses a Postgres interval in the postgres and
+// postgres_verbose styles, such as "-1 days +02:03:04.5" or "@ 1 hour 30 mins".
+// ok is false if s is not one.
+func parseIntervalDuration(s string) (d time.Duration, ok bool, err error) {
+	fields := strings.Fields(s)
+	if len(fields) > 0 && fields[0] == "@" {
+		fields = fields[1:]
+	}
+	if len(fields) == 0 {
+		return 0, false, nil
+	}
+	total := new(big.Rat)
+	for len(fields) > 0 {
+		f := fields[0]
+		if strings.Contains(f, ":") {
+			clock, ok := parseClock(f)
+			if !ok {
+				return 0, false, nil
+			}
+			total.Add(total, clock)
+			fields = fields[1:]
+			continue
+		}
+		if len(fields) < 2 || !intervalNumber.MatchString(f) {
+			return 0, false, nil
+		}
+		n, ok := new(big.Rat).SetString(f)
+		if !ok {
+			return 0, false, nil
+		}
+		unit, ok := intervalUnits[fields[1]]
+		if !ok {
+			switch strings.TrimSuffix(fields[1], "s") {
+			case "mon", "month", "year":
+				return 0, true, errDurationMonths
+			}
+			return 0, false, nil
+		}
+		total.Add(total, n.Mul(n, new(big.Rat).SetInt64(int64(unit))))
+		fields = fields[2:]
+	}
+	d, err = ratDuration(total)
+	return d, true, err
+}
+
+// parseClock parses [+-]H:MM[:SS[.fraction]] as nanoseconds.
+func parseClock(s string) (*big.Rat, bool) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, false
+	}
+	total := new(big.Rat)
+	for i, p := range parts {
+		if p == "" || strings.Trim(p, "0123456789.") != "" || (i < 2 && strings.Contains(p, ".")) {
+			return nil, false
+		}
+		n, ok := new(big.Rat).SetString(p)
+		if !ok {
+			return nil, false
+		}
+		unit := [...]time.Duration{time.Hour, time.Minute, time.Second}[i]
+		total.Add(total, n.Mul(n, new(big.Rat).SetInt64(int64(unit))))
+	}
+	if neg {
+		total.Neg(total)
+	}
+	return total, true
+}
+
+// ratDuration rounds the nanoseconds r to a Duration, checking its range.
+func ratDuration(r *big.Rat) (time.Duration, error) {
+	n := new(big.Int).Quo(r.Num(), r.Denom())
+	rem := new(big.Rat).Sub(r, new(big.Rat).SetInt(n))
+	if rem.Abs(rem).Cmp(big.NewRat(1, 2)) >= 0 {
+		n.Add(n, big.NewInt(int64(r.Sign())))
+	}
+	if !n.IsInt64() {
+		return 0, errDurationRange
+	}
+	return time.Duration(n.Int64()), nil
+}
+
+// ScaleDuration returns the number s, such as "90" or "1.5", as a number of units.
+// ok is false if s is not a number.
+func ScaleDuration(s string, unit time.Duration) (d time.Duration, ok bool, err error) {
+	if s == "" || strings.Trim(s, "+-0123456789.eE") != "" {
+		return 0, false, nil
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		// Bound the exponent before big.Rat expands it.
+		if e, err := strconv.Atoi(s[i+1:]); err != nil || e > 30 || e < -30 {
+			return 0, err == nil, errDurationRange
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, false, nil
+	}
+	d, err = ratDuration(r.Mul(r, new(big.Rat).SetInt64(int64(unit))))
+	return d, true, err
+}
+
+// FormatDuration returns d as a number of units, such as "1.5" seconds,
+// without losing precision.
+func FormatDuration(d time.Duration, unit time.Duration) string {
+	if d%unit == 0 {
+		return strconv.FormatInt(int64(d/unit), 10)
+	}
+	s := big.NewRat(int64(d), int64(unit)).FloatString(9)
+	return strings.TrimRight(s, "0")
+}
+
+// FormatISODuration returns d as an ISO 8601 duration in hours, minutes
+// and seconds, such as "PT1H30M" or "-PT0.5S". Days are not used,
+// because a day is not always 24 hours long to a calendar.
+func FormatISODuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	var buf strings.Builder
+	u := uint64(d)
+	if d < 0 {
+		buf.WriteByte('-')
+		u = -u
+	}
+	buf.WriteString("PT")
+	h, m, ns := u/uint64(time.Hour), u/uint64(time.Minute)%60, u%uint64(time.Minute)
+	if h > 0 {
+		buf.WriteString(strconv.FormatUint(h, 10) + "H")
+	}
+	if m > 0 {
+		buf.WriteString(strconv.FormatUint(m, 10) + "M")
+	}
+	if ns > 0 {
+		buf.WriteString(FormatDuration(time.Duration(ns), time.Second) + "S")
+	}
+	return buf.String()
+}
+
+// DurationDriverValue returns d as a whole number of units for SQL,
+// or as a float if it is not a whole number.
+func DurationDriverValue(d time.Duration, unit time.Duration) interface{} {
+	if d%unit == 0 {
+		return int64(d / unit)
+	}
+	return float64(d) / float64(unit)
+}
+
+// ScanDuration converts a value read from a database driver into a Duration.
+// Integers and floats are a number of units, as a BIGINT column holds them.
+// Strings and []byte values may hold a number of units or any form
+// accepted by ParseDuration, including Postgres interval output.
+func ScanDuration(src interface{}, typ reflect.Type, unit time.Duration) (d time.Duration, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return 0, false, nil
+	case int64:
+		d, _, err = ScaleDuration(strconv.FormatInt(x, 10), unit)
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return 0, false, rangeError(src, typ)
+		}
+		d, _, err = ScaleDuration(strconv.FormatFloat(x, 'g', -1, 64), unit)
+	case []byte:
+		d, err = parseDurationUnits(string(x), unit)
+	case string:
+		d, err = parseDurationUnits(x, unit)
+	default:
+		return 0, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		return 0, false, scanError(src, typ, err)
+	}
+	return d, true, nil
+}
+
+func parseDurationUnits(s string, unit time.Duration) (time.Duration, error) {
+	if d, ok, err := ScaleDuration(s, unit); ok {
+		return d, err
+	}
+	return ParseDuration(s)
+}
+
+// UnmarshalDurationJSON decodes a JSON number of units or a string in any
+// form accepted by ParseDuration. valid is false for JSON null, and for
+// a blank string if p allows it. Numeric strings are a number of units
+// if p allows them. typ is the destination type reported in errors.
+func UnmarshalDurationJSON(data []byte, typ reflect.Type, p Policy, unit time.Duration) (d time.Duration, valid bool, err error) {
+	var x interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&x); err != nil {
+		return 0, false, err
+	}
+	switch x := x.(type) {
+	case json.Number:
+		if d, _, err = ScaleDuration(string(x), unit); err != nil {
+			return 0, false, typeError("number "+string(x), typ)
+		}
+		return d, true, nil
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return 0, false, nil
+		}
+		if d, ok, err := ScaleDuration(x, unit); ok {
+			if !p.NumberStrings || err != nil {
+				return 0, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			return d, true, nil
+		}
+		if d, err = ParseDuration(x); err != nil {
+			return 0, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return d, true, nil
+	case nil:
+		return 0, false, nil
+	case bool:
+		return 0, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return 0, false, typeError("object", typ)
+	default:
+		return 0, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalDurationText decodes a number of units or any form accepted by
+// ParseDuration. valid is false for blank input and "null".
+func UnmarshalDurationText(text []byte, unit time.Duration) (d time.Duration, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return 0, false, nil
+	}
+	if d, err = parseDurationUnits(str, unit); err != nil {
+		return 0, false, fmt.Errorf("null: cannot parse %q: %w", str, err)
+	}
+	return d, true, nil
+}
+
+// DecodeBSONDuration decodes the raw bytes of a BSON number of units,
+// or a string in any form accepted by ParseDuration.
+// valid is false for BSON null and undefined.
+func DecodeBSONDuration(kind byte, data []byte, typ reflect.Type, unit time.Duration) (d time.Duration, valid bool, err error) {
+	var s string
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return 0, false, nil
+	case BSONInt32, BSONInt64:
+		var n int64
+		if n, _, err = DecodeBSONValue[int64](kind, data, typ); err != nil {
+			return 0, false, err
+		}
+		s = strconv.FormatInt(n, 10)
+	case BSONDouble:
+		var f float64
+		if f, _, err = DecodeBSONValue[float64](kind, data, typ); err != nil {
+			return 0, false, err
+		}
+		s = strconv.FormatFloat(f, 'g', -1, 64)
+	case BSONDecimal128:
+		if s, err = DecodeDecimal128(data); err != nil {
+			return 0, false, err
+		}
+	case BSONString:
+		if s, err = readBSONString(data); err != nil {
+			return 0, false, err
+		}
+	default:
+		return 0, false, KindError(kind, typ)
+	}
+	if d, err = parseDurationUnits(s, unit); err != nil {
+		return 0, false, fmt.Errorf("null: cannot decode BSON %q into %v: %w", s, typ, err)
+	}
+	return d, true, nil
+}
+
+// EncodeBSONDuration returns d as the raw bytes of a BSON number of units:
+// an int64 if it is a whole number, and a double otherwise.
+func EncodeBSONDuration(d time.Duration, unit time.Duration) (kind byte, data []byte, err error) {
+	if d%unit == 0 {
+		return EncodeBSONValue(int64(d / unit))
+	}
+	return EncodeBSONValue(float64(d) / float64(unit))
+}
@@ -0,0 +1,351 @@
+package scalar
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ScanJSON converts a value read from a database driver into a JSON document.
+// The document is validated and copied. valid is false for SQL NULL
+// and for the JSON null literal, so both read back the same way.
+func ScanJSON(src interface{}, typ reflect.Type) (raw json.RawMessage, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return nil, false, nil
+	case []byte:
+		raw = x
+	case string:
+		raw = json.RawMessage(x)
+	default:
+		return nil, false, scanError(src, typ, nil)
+	}
+	if !json.Valid(raw) {
+		return nil, false, scanError(src, typ, errors.New("invalid JSON"))
+	}
+	if IsJSONNull(raw) {
+		return nil, false, nil
+	}
+	return append(json.RawMessage{}, raw...), true, nil
+}
+
+// UnmarshalJSONText decodes a JSON document held as text.
+// valid is false for blank input and for the JSON null literal.
+func UnmarshalJSONText(text []byte, typ reflect.Type) (raw json.RawMessage, valid bool, err error) {
+	if len(bytes.TrimSpace(text)) == 0 {
+		return nil, false, nil
+	}
+	if !json.Valid(text) {
+		return nil, false, fmt.Errorf("null: cannot unmarshal invalid JSON into %v", typ)
+	}
+	if IsJSONNull(text) {
+		return nil, false, nil
+	}
+	return append(json.RawMessage{}, text...), true, nil
+}
+
+// IsJSONNull reports whether raw is the JSON null literal.
+func IsJSONNull(raw []byte) bool {
+	return string(bytes.TrimSpace(raw)) == "null"
+}
+
+// EncodeBSONJSON converts a JSON document into the BSON value that represents it.
+// Objects become documents with their keys in order, arrays become arrays,
+// integers become int32 or int64 depending on their size, and other numbers
+// become doubles.
+func EncodeBSONJSON(raw []byte) (kind byte, data []byte, err error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	kind, data, err = encodeJSONValue(dec)
+	if err != nil {
+		return 0, nil, fmt.Errorf("null: cannot encode JSON as BSON: %w", err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return 0, nil, errors.New("null: cannot encode JSON as BSON: trailing data")
+	}
+	return kind, data, nil
+}
+
+func encodeJSONValue(dec *json.Decoder) (kind byte, data []byte, err error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return 0, nil, err
+	}
+	switch x := tok.(type) {
+	case nil:
+		return BSONNull, nil, nil
+	case bool:
+		if x {
+			return BSONBool, []byte{1}, nil
+		}
+		return BSONBool, []byte{0}, nil
+	case string:
+		return BSONString, AppendBSONString(nil, x), nil
+	case json.Number:
+		return encodeJSONNumber(x)
+	case json.Delim:
+		if x == '{' {
+			return encodeJSONObject(dec)
+		}
+		return encodeJSONArray(dec)
+	default:
+		return 0, nil, fmt.Errorf("unexpected token %v", tok)
+	}
+}
+
+func encodeJSONNumber(n json.Number) (kind byte, data []byte, err error) {
+	if i, err := n.Int64(); err == nil {
+		if i >= math.MinInt32 && i <= math.MaxInt32 {
+			return BSONInt32, binary.LittleEndian.AppendUint32(nil, uint32(i)), nil
+		}
+		return BSONInt64, binary.LittleEndian.AppendUint64(nil, uint64(i)), nil
+	}
+	f, err := n.Float64()
+	if err != nil {
+		return 0, nil, fmt.Errorf("number %s is out of range", n)
+	}
+	return BSONDouble, binary.LittleEndian.AppendUint64(nil, math.Float64bits(f)), nil
+}
+
+func encodeJSONObject(dec *json.Decoder) (kind byte, data []byte, err error) {
+	doc := make([]byte, 4, 64)
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return 0, nil, err
+		}
+		key := tok.(string)
+		if strings.IndexByte(key, 0) >= 0 {
+			return 0, nil, fmt.Errorf("key %q contains a NUL byte", key)
+		}
+		if doc, err = appendJSONElement(doc, key, dec); err != nil {
+			return 0, nil, err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return 0, nil, err
+	}
+	return BSONDocument, closeBSONDocument(doc), nil
+}
+
+func encodeJSONArray(dec *json.Decoder) (kind byte, data []byte, err error) {
+	doc := make([]byte, 4, 64)
+	for i := 0; dec.More(); i++ {
+		if doc, err = appendJSONElement(doc, strconv.Itoa(i), dec); err != nil {
+			return 0, nil, err
+		}
+	}
+	if _, err := dec.Token(); err != nil {
+		return 0, nil, err
+	}
+	return BSONArray, closeBSONDocument(doc), nil
+}
+
+func appendJSONElement(doc []byte, key string, dec *json.Decoder) ([]byte, error) {
+	kind, data, err := encodeJSONValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	doc = append(doc, kind)
+	doc = append(doc, key...)
+	doc = append(doc, 0)
+	return append(doc, data...), nil
+}
+
+// closeBSONDocument terminates doc, whose first four bytes are reserved
+// for its length, and fills in the length.
+func closeBSONDocument(doc []byte) []byte {
+	doc = append(doc, 0)
+	binary.LittleEndian.PutUint32(doc, uint32(len(doc)))
+	return doc
+}
+
+// DecodeBSONJSON converts the raw bytes of a BSON value into JSON.
+// valid is false for BSON null and undefined. Documents and arrays become
+// objects and arrays, and numbers become JSON numbers. Values JSON has no
+// form for are written as strings: UUIDs in canonical form, other binary
+// data in base64, ObjectIDs in hex and datetimes in RFC 3339 form.
+// Regular expressions, timestamps, and NaN and infinite numbers are rejected.
+func DecodeBSONJSON(kind byte, data []byte, typ reflect.Type) (raw json.RawMessage, valid bool, err error) {
+	if kind == BSONNull || kind == BSONUndefined {
+		return nil, false, nil
+	}
+	raw, err = appendBSONJSON(nil, kind, data, typ)
+	if err != nil {
+		return nil, false, err
+	}
+	return raw, true, nil
+}
+
+func appendBSONJSON(dst []byte, kind byte, data []byte, typ reflect.Type) ([]byte, error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return append(dst, "null"...), nil
+	case BSONBool:
+		if len(data) != 1 {
+			return nil, errBSONLength
+		}
+		return strconv.AppendBool(dst, data[0] != 0), nil
+	case BSONString:
+		s, err := readBSONString(data)
+		if err != nil {
+			return nil, err
+		}
+		return appendJSONString(dst, s), nil
+	case BSONInt32:
+		if len(data) != 4 {
+			return nil, errBSONLength
+		}
+		return strconv.AppendInt(dst, int64(int32(binary.LittleEndian.Uint32(data))), 10), nil
+	case BSONInt64:
+		if len(data) != 8 {
+			return nil, errBSONLength
+		}
+		return strconv.AppendInt(dst, int64(binary.LittleEndian.Uint64(data)), 10), nil
+	case BSONDouble:
+		if len(data) != 8 {
+			return nil, errBSONLength
+		}
+		f := math.Float64frombits(binary.LittleEndian.Uint64(data))
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return nil, fmt.Errorf("null: BSON double %v cannot be represented in JSON", f)
+		}
+		return strconv.AppendFloat(dst, f, 'g', -1, 64), nil
+	case BSONDecimal128:
+		s, err := DecodeDecimal128(data)
+		if err != nil {
+			return nil, err
+		}
+		if strings.HasSuffix(s, "NaN") || strings.HasSuffix(s, "Inf") {
+			return nil, fmt.Errorf("null: BSON decimal128 %s cannot be represented in JSON", s)
+		}
+		return append(dst, s...), nil
+	case BSONDatetime:
+		t, _, err := DecodeBSONTimeValue(kind, data, typ)
+		if err != nil {
+			return nil, err
+		}
+		return appendJSONString(dst, t.Format(time.RFC3339Nano)), nil
+	case BSONObjectID:
+		if len(data) != 12 {
+			return nil, errBSONLength
+		}
+		return appendJSONString(dst, hex.EncodeToString(data)), nil
+	case BSONBinary:
+		if len(data) >= 5 && data[4] == bsonUUIDSubtype {
+			u, _, err := DecodeBSONUUID(kind, data, typ)
+			if err != nil {
+				return nil, err
+			}
+			return appendJSONString(dst, u.String()), nil
+		}
+		b, _, err := DecodeBSONBytes(kind, data, typ)
+		if err != nil {
+			return nil, err
+		}
+		return appendJSONString(dst, base64.StdEncoding.EncodeToString(b)), nil
+	case BSONDocument, BSONArray:
+		return appendBSONDocumentJSON(dst, kind == BSONArray, data, typ)
+	default:
+		return nil, KindError(kind, typ)
+	}
+}
+
+func appendBSONDocumentJSON(dst []byte, array bool, data []byte, typ reflect.Type) ([]byte, error) {
+	if len(data) < 5 || int(binary.LittleEndian.Uint32(data)) != len(data) || data[len(data)-1] != 0 {
+		return nil, errBSONLength
+	}
+	start, stop := byte('{'), byte('}')
+	if array {
+		start, stop = '[', ']'
+	}
+	dst = append(dst, start)
+	elems := data[4 : len(data)-1]
+	for first := true; len(elems) > 0; first = false {
+		kind := elems[0]
+		end := bytes.IndexByte(elems[1:], 0)
+		if end < 0 {
+			return nil, errBSONLength
+		}
+		key := string(elems[1 : end+1])
+		elems = elems[end+2:]
+		n, err := bsonValueLength(kind, elems)
+		if err != nil {
+			return nil, err
+		}
+		if !first {
+			dst = append(dst, ',')
+		}
+		if !array {
+			dst = appendJSONString(dst, key)
+			dst = append(dst, ':')
+		}
+		if dst, err = appendBSONJSON(dst, kind, elems[:n], typ); err != nil {
+			return nil, err
+		}
+		elems = elems[n:]
+	}
+	return append(dst, stop), nil
+}
+
+// bsonValueLength returns the length of the BSON value of the given kind
+// at the start of data.
+func bsonValueLength(kind byte, data []byte) (int, error) {
+	var n int
+	switch kind {
+	case BSONNull, BSONUndefined:
+		n = 0
+	case BSONBool:
+		n = 1
+	case BSONInt32:
+		n = 4
+	case BSONDouble, BSONInt64, BSONDatetime, BSONTimestamp:
+		n = 8
+	case BSONObjectID:
+		n = 12
+	case BSONDecimal128:
+		n = 16
+	case BSONString, BSONBinary, BSONDocument, BSONArray:
+		if len(data) < 4 {
+			return 0, errBSONLength
+		}
+		n = int(binary.LittleEndian.Uint32(data))
+		switch kind {
+		case BSONString:
+			n += 4
+		case BSONBinary:
+			n += 5
+		}
+	case BSONRegex:
+		pattern := bytes.IndexByte(data, 0)
+		if pattern < 0 {
+			return 0, errBSONLength
+		}
+		options := bytes.IndexByte(data[pattern+1:], 0)
+		if options < 0 {
+			return 0, errBSONLength
+		}
+		n = pattern + options + 2
+	default:
+		return 0, fmt.Errorf("null: unsupported BSON kind 0x%02x", kind)
+	}
+	if n < 0 || n > len(data) {
+		return 0, errBSONLength
+	}
+	return n, nil
+}
+
+func appendJSONString(dst []byte, s string) []byte {
+	b, _ := json.Marshal(s)
+	return append(dst, b...)
+}
@@ -0,0 +1,28 @@
+package scalar
+
+// Policy controls which JSON and text input is accepted in place of the
+// native form of a type. Every type applies a Policy the same way, so a
+// Policy describes the input it accepts rather than the types it affects.
+type Policy struct {
+	// NumberStrings accepts a number quoted as a JSON string, such as "12",
+	// for numeric types. The quoted text must be a decimal number as JSON
+	// writes it, so "NaN", "Inf" and hex floats are still rejected.
+	NumberStrings bool
+	// BoolStrings accepts a bool quoted as a JSON string for bool types,
+	// and for JSON strings and text accepts every form understood by
+	// strconv.ParseBool, such as "1", "t" and "FALSE".
+	BoolStrings bool
+	// EmptyStringNull decodes a blank JSON string as null for types
+	// that are not strings.
+	EmptyStringNull bool
+}
+
+var (
+	// Strict accepts only the native JSON kind of each type, and null.
+	Strict = Policy{}
+	// Lenient accepts numeric strings, bool strings and blank strings as null.
+	Lenient = Policy{NumberStrings: true, BoolStrings: true, EmptyStringNull: true}
+	// Default is used by the UnmarshalJSON and UnmarshalText methods.
+	// It accepts numeric strings and blank strings as null, but not bool strings.
+	Default = Policy{NumberStrings: true, EmptyStringNull: true}
+)
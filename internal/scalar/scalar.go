@@ -0,0 +1,421 @@
+// Package scalar holds the encoding logic shared by every nullable scalar type
+// in the null and zero packages, so that each width behaves identically.
+package scalar
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Scalar is the set of types that can be wrapped by a generic nullable value.
+type Scalar interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64 |
+		~bool | ~string
+}
+
+// Parse parses the text form of a T.
+// Integers must be base 10, floats are parsed at the precision of T,
+// and bools must be exactly "true" or "false".
+func Parse[T Scalar](s string) (T, error) {
+	var v T
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return v, err
+		}
+		rv.SetFloat(f)
+	case reflect.Bool:
+		switch s {
+		case "true":
+			rv.SetBool(true)
+		case "false":
+			rv.SetBool(false)
+		default:
+			return v, errors.New("invalid input:" + s)
+		}
+	case reflect.String:
+		rv.SetString(s)
+	}
+	return v, nil
+}
+
+// Format returns the text form of v.
+func Format[T Scalar](v T) string {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	default:
+		return rv.String()
+	}
+}
+
+// MarshalJSON returns the JSON encoding of v.
+// Infinite and NaN floats cannot be represented in JSON and return an error.
+func MarshalJSON[T Scalar](v T) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if f := rv.Float(); math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, &json.UnsupportedValueError{
+				Value: rv,
+				Str:   strconv.FormatFloat(f, 'g', -1, rv.Type().Bits()),
+			}
+		}
+	case reflect.String:
+		return json.Marshal(rv.String())
+	}
+	return []byte(Format(v)), nil
+}
+
+// UnmarshalJSON decodes data into a T using the Default policy.
+func UnmarshalJSON[T Scalar](data []byte, typ reflect.Type) (v T, valid bool, err error) {
+	return UnmarshalJSONPolicy[T](data, typ, Default)
+}
+
+// UnmarshalJSONPolicy decodes data into a T. valid is false for JSON null.
+// Numbers are parsed from their literal text so no precision is lost
+// to an intermediate float64. Quoted numbers and bools and blank strings
+// are accepted as p allows; a quoted number must be written in decimal,
+// so "NaN" and "Inf" are rejected. Objects in the shape of sql.NullInt64
+// and friends ({"Int64":1,"Valid":true}) are supported too.
+// typ is the destination type reported in errors.
+func UnmarshalJSONPolicy[T Scalar](data []byte, typ reflect.Type, p Policy) (v T, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return v, false, err
+	}
+	kind := reflect.ValueOf(v).Kind()
+	switch x := x.(type) {
+	case float64:
+		lit := string(bytes.TrimSpace(data))
+		if kind == reflect.Bool || kind == reflect.String {
+			return v, false, typeError("number "+lit, typ)
+		}
+		if v, err = Parse[T](lit); err != nil {
+			return v, false, typeError("number "+lit, typ)
+		}
+		return v, true, nil
+	case string:
+		switch {
+		case kind == reflect.String:
+			v, _ = Parse[T](x)
+			return v, true, nil
+		case x == "" && p.EmptyStringNull:
+			return v, false, nil
+		case kind == reflect.Bool && p.BoolStrings:
+			if v, err = parseBool[T](x, p); err != nil {
+				return v, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			return v, true, nil
+		case kind != reflect.Bool && p.NumberStrings:
+			// Parse also accepts "NaN", "Inf" and hex floats,
+			// which are not numbers in JSON.
+			if !numberLiteral.MatchString(x) {
+				return v, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			if v, err = Parse[T](x); err != nil {
+				return v, false, typeError("string "+strconv.Quote(x), typ)
+			}
+			return v, true, nil
+		default:
+			return v, false, typeError("string", typ)
+		}
+	case bool:
+		if kind != reflect.Bool {
+			return v, false, typeError("bool", typ)
+		}
+		reflect.ValueOf(&v).Elem().SetBool(x)
+		return v, true, nil
+	case map[string]interface{}:
+		return unmarshalObject[T](data, typ, p)
+	case nil:
+		return v, false, nil
+	default:
+		return v, false, typeError("array", typ)
+	}
+}
+
+// unmarshalObject decodes the sql.NullXXX object form:
+// a "Valid" bool alongside exactly one value key.
+func unmarshalObject[T Scalar](data []byte, typ reflect.Type, p Policy) (v T, valid bool, err error) {
+	var obj map[string]json.RawMessage
+	if err = json.Unmarshal(data, &obj); err != nil {
+		return v, false, err
+	}
+	rawValid, ok := obj["Valid"]
+	if !ok || len(obj) != 2 {
+		return v, false, fmt.Errorf(`json: unmarshalling object into Go value of type %v requires a "Valid" key and one value key`, typ)
+	}
+	if err = json.Unmarshal(rawValid, &valid); err != nil {
+		return v, false, fmt.Errorf(`json: unmarshalling object into Go value of type %v requires key "Valid" to be of type bool`, typ)
+	}
+	for key, raw := range obj {
+		if key == "Valid" {
+			continue
+		}
+		if v, _, err = UnmarshalJSONPolicy[T](raw, typ, p); err != nil {
+			return v, false, err
+		}
+	}
+	return v, valid, nil
+}
+
+// UnmarshalText decodes text into a T using the Default policy.
+func UnmarshalText[T Scalar](text []byte) (v T, valid bool, err error) {
+	return UnmarshalTextPolicy[T](text, Default)
+}
+
+// UnmarshalTextPolicy decodes text into a T. valid is false for blank input,
+// and for "null" unless T is a string type. Bools accept the forms p allows.
+func UnmarshalTextPolicy[T Scalar](text []byte, p Policy) (v T, valid bool, err error) {
+	str := string(text)
+	kind := reflect.ValueOf(v).Kind()
+	if kind == reflect.String {
+		v, _ = Parse[T](str)
+		return v, str != "", nil
+	}
+	if str == "" || str == "null" {
+		return v, false, nil
+	}
+	if kind == reflect.Bool {
+		v, err = parseBool[T](str, p)
+	} else {
+		v, err = Parse[T](str)
+	}
+	if err != nil {
+		return v, false, err
+	}
+	return v, true, nil
+}
+
+// parseBool parses a bool, accepting every strconv.ParseBool form
+// if p allows bool strings and only "true" and "false" otherwise.
+func parseBool[T Scalar](s string, p Policy) (T, error) {
+	if !p.BoolStrings {
+		return Parse[T](s)
+	}
+	var v T
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return v, errors.New("invalid input:" + s)
+	}
+	reflect.ValueOf(&v).Elem().SetBool(b)
+	return v, nil
+}
+
+// Scan converts a value read from a database driver into a T.
+// Integer conversions are range checked and never wrap.
+// typ is the destination type reported in errors.
+func Scan[T Scalar](src interface{}, typ reflect.Type) (v T, valid bool, err error) {
+	if src == nil {
+		return v, false, nil
+	}
+	src = normalize(src)
+	rv := reflect.ValueOf(&v).Elem()
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var n int64
+		switch x := src.(type) {
+		case int64:
+			n = x
+		case uint64:
+			return v, false, rangeError(src, typ)
+		case float64:
+			if x != math.Trunc(x) || x < math.MinInt64 || x >= math.MaxInt64 {
+				return v, false, rangeError(src, typ)
+			}
+			n = int64(x)
+		case []byte, string:
+			if n, err = strconv.ParseInt(asString(x), 10, 64); err != nil {
+				return v, false, scanError(src, typ, err)
+			}
+		default:
+			return v, false, scanError(src, typ, nil)
+		}
+		if rv.OverflowInt(n) {
+			return v, false, rangeError(src, typ)
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var n uint64
+		switch x := src.(type) {
+		case int64:
+			if x < 0 {
+				return v, false, rangeError(src, typ)
+			}
+			n = uint64(x)
+		case uint64:
+			n = x
+		case float64:
+			if x != math.Trunc(x) || x < 0 || x >= math.MaxUint64 {
+				return v, false, rangeError(src, typ)
+			}
+			n = uint64(x)
+		case []byte, string:
+			if n, err = strconv.ParseUint(asString(x), 10, 64); err != nil {
+				return v, false, scanError(src, typ, err)
+			}
+		default:
+			return v, false, scanError(src, typ, nil)
+		}
+		if rv.OverflowUint(n) {
+			return v, false, rangeError(src, typ)
+		}
+		rv.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		switch x := src.(type) {
+		case float64:
+			f = x
+		case int64:
+			f = float64(x)
+		case uint64:
+			f = float64(x)
+		case []byte, string:
+			if f, err = strconv.ParseFloat(asString(x), rv.Type().Bits()); err != nil {
+				return v, false, scanError(src, typ, err)
+			}
+		default:
+			return v, false, scanError(src, typ, nil)
+		}
+		if !math.IsInf(f, 0) && rv.OverflowFloat(f) {
+			return v, false, rangeError(src, typ)
+		}
+		rv.SetFloat(f)
+	case reflect.Bool:
+		b, err := driver.Bool.ConvertValue(src)
+		if err != nil {
+			return v, false, scanError(src, typ, err)
+		}
+		rv.SetBool(b.(bool))
+	case reflect.String:
+		switch x := src.(type) {
+		case []byte, string:
+			rv.SetString(asString(x))
+		case int64, uint64, float64, bool:
+			rv.SetString(fmt.Sprint(x))
+		case time.Time:
+			rv.SetString(x.Format(time.RFC3339Nano))
+		default:
+			return v, false, scanError(src, typ, nil)
+		}
+	}
+	return v, true, nil
+}
+
+// DriverValue converts v into a driver.Value.
+// Unsigned values above math.MaxInt64 do not fit the int64 a driver
+// expects, so they are passed as their decimal string instead.
+func DriverValue[T Scalar](v T) (driver.Value, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return strconv.FormatUint(u, 10), nil
+		}
+		return int64(u), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	default:
+		return rv.String(), nil
+	}
+}
+
+// IsZero reports whether v is the zero value of T.
+func IsZero[T Scalar](v T) bool {
+	var zero T
+	return v == zero
+}
+
+// normalize converts any Go numeric, bool or string kind into the
+// canonical driver.Value types, so hand-written Scan calls such as
+// Scan(12345) behave like values coming from a driver.
+// Unsigned values above math.MaxInt64 stay uint64.
+func normalize(src interface{}) interface{} {
+	switch src.(type) {
+	case int64, float64, bool, []byte, string:
+		return src
+	}
+	rv := reflect.ValueOf(src)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if u := rv.Uint(); u > math.MaxInt64 {
+			return u
+		}
+		return int64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return rv.Float()
+	case reflect.Bool:
+		return rv.Bool()
+	case reflect.String:
+		return rv.String()
+	}
+	return src
+}
+
+func asString(src interface{}) string {
+	if b, ok := src.([]byte); ok {
+		return string(b)
+	}
+	return src.(string)
+}
+
+func typeError(value string, typ reflect.Type) error {
+	return &json.UnmarshalTypeError{Value: value, Type: typ}
+}
+
+func scanError(src interface{}, typ reflect.Type, err error) error {
+	if err != nil {
+		return fmt.Errorf("null: cannot scan type %T into %v: %v: %w", src, typ, printable(src), err)
+	}
+	return fmt.Errorf("null: cannot scan type %T into %v: %v", src, typ, printable(src))
+}
+
+func rangeError(src interface{}, typ reflect.Type) error {
+	return fmt.Errorf("null: %T value %v is out of range for %v", src, printable(src), typ)
+}
+
+// printable keeps []byte sources readable in error messages.
+func printable(src interface{}) interface{} {
+	if b, ok := src.([]byte); ok {
+		return string(b)
+	}
+	return src
+}
@@ -0,0 +1,273 @@
+package scalar
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TimeFormat is how a time is written as JSON and text, and which
+// layouts are accepted when reading it. Layouts are in time.Parse form.
+type TimeFormat struct {
+	// Layout is the layout times are written in. It is also accepted as input.
+	Layout string
+	// Accept lists further layouts accepted as input, tried in order.
+	Accept []string
+	// Location, if not nil, is the location times are converted to when they
+	// are scanned, decoded and written, so the same instant is always written
+	// the same way. It is also the location of input without a zone.
+	// If it is nil, times keep the location they have.
+	Location *time.Location
+	// Precision, if not zero, is the precision times are stored and written at,
+	// such as time.Microsecond for Postgres or time.Millisecond for BSON.
+	// Times are truncated to it, or rounded if Round is true, when they are
+	// passed to SQL or BSON and when they are written, so a time that is
+	// stored and read back is unchanged.
+	Precision time.Duration
+	// Round makes times round to the nearest multiple of Precision
+	// instead of being truncated.
+	Round bool
+	// ScanUnit is the unit of integers scanned from SQL, such as
+	// time.Millisecond for columns that hold milliseconds since the Unix epoch.
+	// If it is zero, integers are Unix seconds.
+	ScanUnit time.Duration
+	// Null is the time that stands for null in zero.LayoutTime:
+	// times equal to it are decoded as null, and null is written as it.
+	// null.LayoutTime writes null as JSON null and ignores it.
+	Null Sentinel
+}
+
+// Sentinel is a time that stands for null, for sources that cannot store null.
+type Sentinel int
+
+const (
+	// GoZero is time.Time{}, "0001-01-01T00:00:00Z".
+	GoZero Sentinel = iota
+	// UnixEpoch is Unix time 0, "1970-01-01T00:00:00Z",
+	// which some devices send to mean an unknown time.
+	UnixEpoch
+	// MySQLZeroDate is "0000-00-00 00:00:00", the zero DATETIME of legacy MySQL.
+	// It is outside the range of time.Time, so null is written as that string.
+	MySQLZeroDate
+)
+
+var unixEpoch = time.Unix(0, 0).UTC()
+
+const mysqlZeroDate = "0000-00-00 00:00:00"
+
+// IsNull reports whether t stands for null under s.
+// time.Time{} always does.
+func (s Sentinel) IsNull(t time.Time) bool {
+	return t.IsZero() || s == UnixEpoch && t.Equal(unixEpoch)
+}
+
+// Text returns s written in f.
+func (s Sentinel) Text(f TimeFormat) string {
+	switch s {
+	case UnixEpoch:
+		return f.Format(unixEpoch)
+	case MySQLZeroDate:
+		return mysqlZeroDate
+	default:
+		return f.Format(time.Time{})
+	}
+}
+
+// Built-in time formats.
+var (
+	// RFC3339 is the format of Time: RFC 3339 in whole seconds.
+	// Fractional seconds and offsets without a colon are accepted as input.
+	RFC3339 = TimeFormat{
+		Layout: time.RFC3339,
+		Accept: []string{"2006-01-02T15:04:05.999999999Z0700"},
+	}
+	// RFC3339Nano is RFC 3339 with fractional seconds, as time.Time marshals itself.
+	RFC3339Nano = TimeFormat{
+		Layout: time.RFC3339Nano,
+		Accept: []string{"2006-01-02T15:04:05.999999999Z0700"},
+	}
+	// UTC is RFC3339Nano with every time converted to UTC.
+	UTC = TimeFormat{
+		Layout:   time.RFC3339Nano,
+		Accept:   []string{"2006-01-02T15:04:05.999999999Z0700"},
+		Location: time.UTC,
+	}
+	// MySQL is the DATETIME format, "2006-01-02 15:04:05", with optional
+	// fractional seconds as input. It has no zone, so times are written on
+	// their own wall clock and read in UTC.
+	MySQL = TimeFormat{
+		Layout: "2006-01-02 15:04:05",
+		Accept: []string{"2006-01-02 15:04:05.999999999"},
+	}
+	// HTTP is the date format of HTTP headers, always in UTC.
+	// The obsolete RFC 850 and ANSI C forms are accepted as input, like http.ParseTime.
+	HTTP = TimeFormat{
+		Layout:   http.TimeFormat,
+		Accept:   []string{time.RFC850, time.ANSIC},
+		Location: time.UTC,
+	}
+)
+
+// IsMySQLZeroDate reports whether s is a MySQL zero date or datetime,
+// such as "0000-00-00", "0000-00-00 00:00:00" or "0000-00-00T00:00:00.000".
+func IsMySQLZeroDate(s string) bool {
+	rest := strings.TrimPrefix(s, "0000-00-00")
+	return len(rest) < len(s) && strings.Trim(rest, "0:. TZ") == ""
+}
+
+// Format returns t at f.Precision, written in f.Layout.
+func (f TimeFormat) Format(t time.Time) string {
+	return f.Normalize(f.Store(t)).Format(f.Layout)
+}
+
+// Store returns t at f.Precision, as it is passed to SQL and BSON.
+func (f TimeFormat) Store(t time.Time) time.Time {
+	return AtPrecision(t, f.Precision, f.Round)
+}
+
+// Normalize returns t in f.Location.
+func (f TimeFormat) Normalize(t time.Time) time.Time {
+	return InLocation(t, f.Location)
+}
+
+// Parse parses s in f.Layout or any of f.Accept.
+// Input without a zone is read in f.Location, or UTC if it is nil.
+func (f TimeFormat) Parse(s string) (time.Time, error) {
+	loc := f.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t, err := time.ParseInLocation(f.Layout, s, loc)
+	if err == nil {
+		return f.Normalize(t), nil
+	}
+	for _, layout := range f.Accept {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return f.Normalize(t), nil
+		}
+	}
+	return time.Time{}, err
+}
+
+// scanText parses a timestamp scanned from SQL, in f or any of the
+// common timestamp layouts.
+func (f TimeFormat) scanText(s string) (time.Time, error) {
+	if t, err := f.Parse(s); err == nil {
+		return t, nil
+	}
+	loc := f.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	return parseTimestampIn(s, loc)
+}
+
+// InLocation returns t in loc. It returns t unchanged if loc is nil,
+// or if t is the zero time, which has no meaningful location.
+func InLocation(t time.Time, loc *time.Location) time.Time {
+	if loc == nil || t.IsZero() {
+		return t
+	}
+	return t.In(loc)
+}
+
+// MarshalTimeJSON returns t as a JSON string written in f.
+func MarshalTimeJSON(t time.Time, f TimeFormat) ([]byte, error) {
+	if y := t.Year(); y < 0 || y >= 10000 {
+		return nil, errors.New("null: time year outside of range [0,9999]")
+	}
+	return json.Marshal(f.Format(t))
+}
+
+// UnmarshalTimeJSON decodes a JSON string in any layout f accepts.
+// valid is false for JSON null, and for a blank string if p allows it.
+// typ is the destination type reported in errors.
+func UnmarshalTimeJSON(data []byte, typ reflect.Type, p Policy, f TimeFormat) (t time.Time, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return t, false, err
+	}
+	switch x := x.(type) {
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return t, false, nil
+		}
+		if t, err = f.Parse(x); err != nil {
+			return t, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return t, true, nil
+	case nil:
+		return t, false, nil
+	case float64:
+		return t, false, typeError("number", typ)
+	case bool:
+		return t, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return t, false, typeError("object", typ)
+	default:
+		return t, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalTimeText decodes a time in any layout f accepts.
+// valid is false for blank input and "null".
+func UnmarshalTimeText(text []byte, f TimeFormat) (t time.Time, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return t, false, nil
+	}
+	if t, err = f.Parse(str); err != nil {
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+// AtPrecision returns t truncated to a multiple of d, or rounded to the
+// nearest one if round is true, without its monotonic clock reading.
+// If d is zero or less, t keeps its full precision.
+func AtPrecision(t time.Time, d time.Duration, round bool) time.Time {
+	switch {
+	case d <= 0:
+		return t.Round(0)
+	case round:
+		return t.Round(d)
+	default:
+		return t.Truncate(d)
+	}
+}
+
+// ScanTime converts a value read from a database driver into a time.
+// time.Time values are used as they are. Strings and []byte values are
+// timestamps in text form, as SQLite TEXT columns and MySQL without
+// parseTime return them. They are parsed like JSON and text in f first,
+// then in the common timestamp layouts, and are read in f.Location, or UTC,
+// if they have no zone. int64 values are a number of f.ScanUnit since the
+// Unix epoch, as SQLite INTEGER columns hold them, or seconds if it is zero.
+func ScanTime(src interface{}, typ reflect.Type, f TimeFormat) (t time.Time, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return t, false, nil
+	case time.Time:
+		return x, true, nil
+	case int64:
+		unit := f.ScanUnit
+		if unit <= 0 {
+			unit = time.Second
+		}
+		t, _, err = ParseUnix(strconv.FormatInt(x, 10), unit)
+	case []byte:
+		t, err = f.scanText(string(x))
+	case string:
+		t, err = f.scanText(x)
+	default:
+		return t, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		return t, false, scanError(src, typ, err)
+	}
+	return t, true, nil
+}
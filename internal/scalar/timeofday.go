@@ -0,0 +1,105 @@
+package scalar
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+// ScanTimeOfDay converts a value read from a database driver into a TimeOfDay.
+// A time.Time is read on its own wall clock, and strings and []byte values
+// hold "15:04:05" with an optional fraction, as TIME columns return them.
+func ScanTimeOfDay(src interface{}, typ reflect.Type) (t civil.TimeOfDay, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return t, false, nil
+	case time.Time:
+		return civil.TimeOfDayOf(x), true, nil
+	case []byte:
+		t, err = civil.ParseTimeOfDay(string(x))
+	case string:
+		t, err = civil.ParseTimeOfDay(x)
+	default:
+		return t, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		// The civil error repeats the input, which scanError reports.
+		return t, false, scanError(src, typ, errTimeOfDay)
+	}
+	return t, true, nil
+}
+
+var errTimeOfDay = errors.New(`invalid time of day, want "15:04:05" with an optional fraction of up to nine digits, or "15:04"`)
+
+// UnmarshalTimeOfDayJSON decodes a JSON string in "15:04:05" form.
+// valid is false for JSON null, and for a blank string if p allows it.
+// typ is the destination type reported in errors.
+func UnmarshalTimeOfDayJSON(data []byte, typ reflect.Type, p Policy) (t civil.TimeOfDay, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return t, false, err
+	}
+	switch x := x.(type) {
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return t, false, nil
+		}
+		if t, err = civil.ParseTimeOfDay(x); err != nil {
+			return t, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return t, true, nil
+	case nil:
+		return t, false, nil
+	case float64:
+		return t, false, typeError("number", typ)
+	case bool:
+		return t, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return t, false, typeError("object", typ)
+	default:
+		return t, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalTimeOfDayText decodes a time in "15:04:05" form.
+// valid is false for blank input and "null".
+func UnmarshalTimeOfDayText(text []byte) (t civil.TimeOfDay, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return t, false, nil
+	}
+	if t, err = civil.ParseTimeOfDay(str); err != nil {
+		return t, false, err
+	}
+	return t, true, nil
+}
+
+// DecodeBSONTimeOfDay decodes the raw bytes of a BSON string in "15:04:05" form.
+// valid is false for BSON null and undefined.
+func DecodeBSONTimeOfDay(kind byte, data []byte, typ reflect.Type) (t civil.TimeOfDay, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return t, false, nil
+	case BSONString:
+		s, err := readBSONString(data)
+		if err != nil {
+			return t, false, err
+		}
+		if t, err = civil.ParseTimeOfDay(s); err != nil {
+			return t, false, err
+		}
+		return t, true, nil
+	default:
+		return t, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONTimeOfDay returns t as the raw bytes of a BSON string,
+// since BSON has no time of day type.
+func EncodeBSONTimeOfDay(t civil.TimeOfDay) (kind byte, data []byte) {
+	return BSONString, AppendBSONString(nil, t.String())
+}
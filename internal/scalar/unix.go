@@ -0,0 +1,185 @@
+package scalar
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timestampLayouts are the layouts of timestamps in text form,
+// as JSON APIs, SQL text protocols and SQLite TEXT columns write them.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z07",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// parseTimestamp parses a timestamp in any of timestampLayouts.
+// Timestamps without a zone are read in UTC.
+func parseTimestamp(s string) (time.Time, error) {
+	return parseTimestampIn(s, time.UTC)
+}
+
+// parseTimestampIn is parseTimestamp with timestamps without a zone read in loc.
+func parseTimestampIn(s string, loc *time.Location) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.ParseInLocation(layout, s, loc); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errTimestamp
+}
+
+// Errors from parsing times leave out the input; callers report it.
+var (
+	errTimestamp = errors.New(`invalid timestamp, want RFC 3339 or "2006-01-02 15:04:05" with an optional fraction and zone`)
+	errUnixRange = errors.New("Unix time is out of range")
+)
+
+var nanosPerSecond = big.NewInt(int64(time.Second))
+
+// ParseUnix parses the number s, such as "1700000000" or "1.5", as a
+// number of units since the Unix epoch. The result is in UTC.
+// ok is false if s is not a number.
+func ParseUnix(s string, unit time.Duration) (t time.Time, ok bool, err error) {
+	if s == "" || strings.Trim(s, "+-0123456789.eE") != "" {
+		return t, false, nil
+	}
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		// Bound the exponent before big.Rat expands it.
+		if e, err := strconv.Atoi(s[i+1:]); err != nil || e > 30 || e < -30 {
+			return t, err == nil, errUnixRange
+		}
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return t, false, nil
+	}
+	r.Mul(r, new(big.Rat).SetInt64(int64(unit)))
+	ns := new(big.Int).Quo(r.Num(), r.Denom())
+	sec, nsec := new(big.Int).DivMod(ns, nanosPerSecond, new(big.Int))
+	if !sec.IsInt64() || sec.Int64() > math.MaxInt64/2 || sec.Int64() < math.MinInt64/2 {
+		return t, true, errUnixRange
+	}
+	return time.Unix(sec.Int64(), nsec.Int64()).UTC(), true, nil
+}
+
+// FormatUnix returns t as a whole number of units since the Unix epoch,
+// rounded down.
+func FormatUnix(t time.Time, unit time.Duration) string {
+	ns := new(big.Int).Mul(big.NewInt(t.Unix()), nanosPerSecond)
+	ns.Add(ns, big.NewInt(int64(t.Nanosecond())))
+	q, _ := new(big.Int).DivMod(ns, big.NewInt(int64(unit)), new(big.Int))
+	return q.String()
+}
+
+// UnixDriverValue returns t as an int64 number of units since the Unix epoch.
+func UnixDriverValue(t time.Time, unit time.Duration) (int64, error) {
+	n, err := strconv.ParseInt(FormatUnix(t, unit), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("null: %v is out of range for a Unix time in %v units", t, unit)
+	}
+	return n, nil
+}
+
+// ScanUnix converts a value read from a database driver into a time.
+// Integers and floats are a number of units since the Unix epoch, as
+// integer columns hold them, and time.Time values are SQL timestamps.
+// Strings and []byte values may hold either.
+func ScanUnix(src interface{}, typ reflect.Type, unit time.Duration) (t time.Time, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return t, false, nil
+	case time.Time:
+		return x, true, nil
+	case int64:
+		t, _, err = ParseUnix(strconv.FormatInt(x, 10), unit)
+	case float64:
+		if math.IsNaN(x) || math.IsInf(x, 0) {
+			return t, false, rangeError(src, typ)
+		}
+		t, _, err = ParseUnix(strconv.FormatFloat(x, 'g', -1, 64), unit)
+	case []byte:
+		t, err = parseUnixOrTimestamp(string(x), unit)
+	case string:
+		t, err = parseUnixOrTimestamp(x, unit)
+	default:
+		return t, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		return t, false, scanError(src, typ, err)
+	}
+	return t, true, nil
+}
+
+func parseUnixOrTimestamp(s string, unit time.Duration) (time.Time, error) {
+	if t, ok, err := ParseUnix(s, unit); ok {
+		return t, err
+	}
+	return parseTimestamp(s)
+}
+
+// UnmarshalUnixJSON decodes a JSON number of units since the Unix epoch.
+// A string holding a number or an RFC 3339 timestamp is accepted only if
+// p allows number strings, since neither is the native JSON form.
+// valid is false for JSON null, and for a blank string if p allows it.
+// typ is the destination type reported in errors.
+func UnmarshalUnixJSON(data []byte, typ reflect.Type, p Policy, unit time.Duration) (t time.Time, valid bool, err error) {
+	var x interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err = dec.Decode(&x); err != nil {
+		return t, false, err
+	}
+	switch x := x.(type) {
+	case json.Number:
+		if t, _, err = ParseUnix(string(x), unit); err != nil {
+			return t, false, typeError("number "+string(x), typ)
+		}
+		return t, true, nil
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return t, false, nil
+		}
+		if !p.NumberStrings {
+			return t, false, typeError("string", typ)
+		}
+		if t, err = parseUnixOrTimestamp(x, unit); err != nil {
+			return t, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return t, true, nil
+	case nil:
+		return t, false, nil
+	case bool:
+		return t, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return t, false, typeError("object", typ)
+	default:
+		return t, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalUnixText decodes a number of units since the Unix epoch,
+// or a timestamp. valid is false for blank input and "null".
+func UnmarshalUnixText(text []byte, unit time.Duration) (t time.Time, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return t, false, nil
+	}
+	if t, err = parseUnixOrTimestamp(str, unit); err != nil {
+		return t, false, fmt.Errorf("null: cannot parse %q: %w", str, err)
+	}
+	return t, true, nil
+}
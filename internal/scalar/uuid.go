@@ -0,0 +1,110 @@
+package scalar
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/conneqtech/null/uuid"
+)
+
+// bsonUUIDSubtype is the BSON binary subtype of RFC 4122 UUIDs.
+const bsonUUIDSubtype = 0x04
+
+// ScanUUID converts a value read from a database driver into a UUID.
+// A []byte of 16 bytes holds the raw UUID, as BINARY(16) columns do,
+// and other strings and []byte values hold its text form.
+func ScanUUID(src interface{}, typ reflect.Type) (u uuid.UUID, valid bool, err error) {
+	switch x := src.(type) {
+	case nil:
+		return u, false, nil
+	case uuid.UUID:
+		return x, true, nil
+	case []byte:
+		if len(x) == len(u) {
+			copy(u[:], x)
+			return u, true, nil
+		}
+		u, err = uuid.Parse(string(x))
+	case string:
+		u, err = uuid.Parse(x)
+	default:
+		return u, false, scanError(src, typ, nil)
+	}
+	if err != nil {
+		return u, false, scanError(src, typ, err)
+	}
+	return u, true, nil
+}
+
+// UnmarshalUUIDJSON decodes a JSON string holding any form accepted
+// by uuid.Parse. valid is false for JSON null, and for a blank string
+// if p allows it. typ is the destination type reported in errors.
+func UnmarshalUUIDJSON(data []byte, typ reflect.Type, p Policy) (u uuid.UUID, valid bool, err error) {
+	var x interface{}
+	if err = json.Unmarshal(data, &x); err != nil {
+		return u, false, err
+	}
+	switch x := x.(type) {
+	case string:
+		if x == "" && p.EmptyStringNull {
+			return u, false, nil
+		}
+		if u, err = uuid.Parse(x); err != nil {
+			return u, false, typeError("string "+strconv.Quote(x), typ)
+		}
+		return u, true, nil
+	case nil:
+		return u, false, nil
+	case float64:
+		return u, false, typeError("number", typ)
+	case bool:
+		return u, false, typeError("bool", typ)
+	case map[string]interface{}:
+		return u, false, typeError("object", typ)
+	default:
+		return u, false, typeError("array", typ)
+	}
+}
+
+// UnmarshalUUIDText decodes text holding any form accepted by uuid.Parse.
+// valid is false for blank input and "null".
+func UnmarshalUUIDText(text []byte) (u uuid.UUID, valid bool, err error) {
+	str := string(text)
+	if str == "" || str == "null" {
+		return u, false, nil
+	}
+	if u, err = uuid.Parse(str); err != nil {
+		return u, false, err
+	}
+	return u, true, nil
+}
+
+// DecodeBSONUUID decodes the raw bytes of a BSON binary value of subtype 4.
+// valid is false for BSON null and undefined.
+func DecodeBSONUUID(kind byte, data []byte, typ reflect.Type) (u uuid.UUID, valid bool, err error) {
+	switch kind {
+	case BSONNull, BSONUndefined:
+		return u, false, nil
+	case BSONBinary:
+		if len(data) != 5+len(u) || binary.LittleEndian.Uint32(data) != uint32(len(u)) {
+			return u, false, errBSONLength
+		}
+		if data[4] != bsonUUIDSubtype {
+			return u, false, fmt.Errorf("null: cannot decode BSON binary subtype 0x%02x into %v", data[4], typ)
+		}
+		copy(u[:], data[5:])
+		return u, true, nil
+	default:
+		return u, false, KindError(kind, typ)
+	}
+}
+
+// EncodeBSONUUID returns u as the raw bytes of a BSON binary value of subtype 4.
+func EncodeBSONUUID(u uuid.UUID) (kind byte, data []byte) {
+	data = binary.LittleEndian.AppendUint32(nil, uint32(len(u)))
+	data = append(data, bsonUUIDSubtype)
+	return BSONBinary, append(data, u[:]...)
+}
@@ -0,0 +1,45 @@
+package null
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestIntString(t *testing.T) {
+	data, err := json.Marshal(IntStringFrom(1<<53 + 1))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"9007199254740993"`, "int string json marshal")
+
+	data, err = json.Marshal(NewIntString(0, false))
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null int string json marshal")
+
+	var i IntString
+	err = json.Unmarshal([]byte(`"9007199254740993"`), &i)
+	maybePanic(err)
+	if i.Int64 != 1<<53+1 || !i.Valid {
+		t.Errorf("bad int string json: %+v", i)
+	}
+	err = json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt(t, i.Int, "int string from number json")
+
+	err = i.UnmarshalJSONWith(intStringJSON, StrictPolicy())
+	maybePanic(err)
+	assertInt(t, i.Int, "strict int string json")
+
+	txt, err := i.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "12345", "int string text marshal")
+
+	var u Uint64String
+	err = json.Unmarshal([]byte(`"18446744073709551615"`), &u)
+	maybePanic(err)
+	if u.Uint64.Uint64 != math.MaxUint64 || !u.Valid {
+		t.Errorf("bad uint64 string json: %+v", u)
+	}
+	data, err = json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"18446744073709551615"`, "uint64 string json marshal")
+}
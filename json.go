@@ -0,0 +1,138 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// JSON is a nullable raw JSON document, for json and jsonb columns.
+// The document is kept verbatim and embedded as-is when marshaled.
+// SQL NULL and the JSON null literal are both considered null.
+type JSON struct {
+	JSON  json.RawMessage
+	Valid bool // Valid is true if JSON is not NULL
+}
+
+// NewJSON creates a new JSON
+func NewJSON(raw []byte, valid bool) JSON {
+	return JSON{
+		JSON:  raw,
+		Valid: valid,
+	}
+}
+
+// JSONFrom creates a new JSON that will be null if raw is nil or the JSON null literal.
+// The document is not validated until it is marshaled.
+func JSONFrom(raw []byte) JSON {
+	return NewJSON(raw, raw != nil && !scalar.IsJSONNull(raw))
+}
+
+// JSONFromValue creates a new JSON holding v encoded with encoding/json.
+// It will be null if v encodes to null.
+func JSONFromValue(v interface{}) (JSON, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return JSON{}, err
+	}
+	return JSONFrom(raw), nil
+}
+
+// ValueOrZero returns the inner document if valid, otherwise nil.
+func (j JSON) ValueOrZero() json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
+// Unmarshal decodes the document into v, like json.Unmarshal.
+// A null JSON decodes as the JSON null literal, so it sets pointers,
+// maps, slices and interfaces to nil and leaves other values unchanged.
+func (j JSON) Unmarshal(v interface{}) error {
+	if !j.Valid {
+		return json.Unmarshal([]byte("null"), v)
+	}
+	return json.Unmarshal(j.JSON, v)
+}
+
+// Scan implements the Scanner interface.
+// It accepts string and []byte values and returns an error
+// if they do not hold a valid JSON document.
+func (j *JSON) Scan(value interface{}) error {
+	var err error
+	j.JSON, j.Valid, err = scalar.ScanJSON(value, reflect.TypeOf(*j))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The document is passed as a string, which json and jsonb columns
+// accept from every driver, unlike []byte.
+func (j JSON) Value() (driver.Value, error) {
+	if !j.Valid {
+		return nil, nil
+	}
+	return string(j.JSON), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports any JSON input, which is kept verbatim.
+// null will be considered a null JSON.
+func (j *JSON) UnmarshalJSON(data []byte) error {
+	if scalar.IsJSONNull(data) {
+		j.JSON, j.Valid = nil, false
+		return nil
+	}
+	j.JSON = append(json.RawMessage{}, data...)
+	j.Valid = true
+	return nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null JSON if the input is blank or "null".
+// It will return an error if the input is not valid JSON.
+func (j *JSON) UnmarshalText(text []byte) error {
+	var err error
+	j.JSON, j.Valid, err = scalar.UnmarshalJSONText(text, reflect.TypeOf(*j))
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this JSON is null, and the document as-is otherwise.
+func (j JSON) MarshalJSON() ([]byte, error) {
+	if !j.Valid || len(j.JSON) == 0 {
+		return []byte("null"), nil
+	}
+	return j.JSON, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this JSON is null.
+func (j JSON) MarshalText() ([]byte, error) {
+	if !j.Valid {
+		return []byte{}, nil
+	}
+	return j.JSON, nil
+}
+
+// SetValid changes this JSON's document and also sets it to be non-null.
+func (j *JSON) SetValid(raw []byte) {
+	j.JSON = raw
+	j.Valid = true
+}
+
+// Ptr returns a pointer to this JSON's document, or a nil pointer if this JSON is null.
+func (j JSON) Ptr() *json.RawMessage {
+	if !j.Valid {
+		return nil
+	}
+	return &j.JSON
+}
+
+// IsZero returns true for invalid JSONs, for future omitempty support.
+// A non-null JSON holding an empty object or array will not be considered zero.
+func (j JSON) IsZero() bool {
+	return !j.Valid
+}
@@ -0,0 +1,124 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+var deviceJSON = []byte(`{"model":"X1","tags":["a","b"],"fw":{"major":2,"minor":10}}`)
+
+func TestJSONFrom(t *testing.T) {
+	j := JSONFrom(deviceJSON)
+	if !j.Valid || string(j.JSON) != string(deviceJSON) {
+		t.Errorf("bad JSONFrom: %+v", j)
+	}
+	if JSONFrom(nil).Valid || JSONFrom([]byte(" null ")).Valid {
+		t.Error("JSONFrom should be null for nil and the null literal")
+	}
+
+	j, err := JSONFromValue(map[string]int{"a": 1})
+	maybePanic(err)
+	assertJSONEquals(t, j.JSON, `{"a":1}`, "JSONFromValue")
+	j, err = JSONFromValue(nil)
+	maybePanic(err)
+	if j.Valid {
+		t.Error("JSONFromValue(nil) is valid, but should be invalid")
+	}
+}
+
+func TestMarshalJSONDocument(t *testing.T) {
+	type device struct {
+		ID   int
+		Meta JSON
+	}
+	data, err := json.Marshal(device{ID: 1, Meta: JSONFrom(deviceJSON)})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"ID":1,"Meta":`+string(deviceJSON)+`}`, "embedded json")
+
+	data, err = json.Marshal(device{ID: 2})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"ID":2,"Meta":null}`, "null json")
+
+	var d device
+	err = json.Unmarshal([]byte(`{"ID":3,"Meta":[1, 2, 3]}`), &d)
+	maybePanic(err)
+	if !d.Meta.Valid || string(d.Meta.JSON) != "[1, 2, 3]" {
+		t.Errorf("document should be kept verbatim: %+v", d.Meta)
+	}
+	err = json.Unmarshal([]byte(`{"ID":4,"Meta":null}`), &d)
+	maybePanic(err)
+	if d.Meta.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+}
+
+func TestJSONUnmarshalInto(t *testing.T) {
+	var meta struct {
+		Model string
+		Tags  []string
+	}
+	err := JSONFrom(deviceJSON).Unmarshal(&meta)
+	maybePanic(err)
+	if meta.Model != "X1" || len(meta.Tags) != 2 {
+		t.Errorf("bad Unmarshal: %+v", meta)
+	}
+
+	m := map[string]int{"a": 1}
+	err = JSON{}.Unmarshal(&m)
+	maybePanic(err)
+	if m != nil {
+		t.Errorf("null Unmarshal should set a map to nil, not %v", m)
+	}
+}
+
+func TestJSONSQL(t *testing.T) {
+	var j JSON
+	buf := append([]byte{}, deviceJSON...)
+	err := j.Scan(buf)
+	maybePanic(err)
+	buf[0] = '['
+	if string(j.JSON) != string(deviceJSON) {
+		t.Errorf("scan should copy the driver buffer: %s", j.JSON)
+	}
+	v, err := j.Value()
+	maybePanic(err)
+	if v != string(deviceJSON) {
+		t.Errorf("bad driver value: %#v", v)
+	}
+
+	err = j.Scan("null")
+	maybePanic(err)
+	if j.Valid {
+		t.Error("scanned null literal", "is valid, but should be invalid")
+	}
+	err = j.Scan(nil)
+	maybePanic(err)
+	if v, _ = j.Value(); v != nil {
+		t.Errorf("null driver value should be nil, not %#v", v)
+	}
+
+	if err = j.Scan(`{"a":`); err == nil {
+		t.Error("expected error scanning invalid JSON")
+	}
+	if err = j.Scan(int64(1)); err == nil {
+		t.Error("expected error scanning an int64")
+	}
+}
+
+func TestJSONText(t *testing.T) {
+	var j JSON
+	err := j.UnmarshalText(deviceJSON)
+	maybePanic(err)
+	txt, err := j.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, string(deviceJSON), "json text")
+
+	err = j.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if j.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+	if err = j.UnmarshalText([]byte("{")); err == nil {
+		t.Error("expected error for invalid JSON text")
+	}
+}
@@ -0,0 +1,150 @@
+package null
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Object is a nullable value of any type stored as a JSON document,
+// for json and jsonb columns that hold a Go struct, map or slice.
+// V is encoded with encoding/json for SQL, JSON and text, so its json
+// struct tags apply there.
+// SQL NULL and the JSON null literal are both considered null.
+//
+// With the BSON build tags, V is encoded with the driver's own codec, so a
+// struct is stored as a subdocument named by its bson struct tags and a
+// time.Time field as a BSON datetime. If the driver cannot encode V, such as
+// for a struct with a channel field, V is stored as the BSON form of its
+// JSON instead. Such a value is read back with the driver's codec when it
+// can decode it, so give its type json tags that match its BSON field names.
+type Object[T any] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NewObject creates a new Object.
+func NewObject[T any](v T, valid bool) Object[T] {
+	return Object[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// ObjectFrom creates a new Object that will always be valid.
+func ObjectFrom[T any](v T) Object[T] {
+	return NewObject(v, true)
+}
+
+// ObjectFromPtr creates a new Object that will be null if v is nil.
+func ObjectFromPtr[T any](v *T) Object[T] {
+	if v == nil {
+		var zero T
+		return NewObject(zero, false)
+	}
+	return NewObject(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (o Object[T]) ValueOrZero() T {
+	if !o.Valid {
+		var zero T
+		return zero
+	}
+	return o.V
+}
+
+// Scan implements the Scanner interface.
+// It accepts string and []byte values holding a JSON document
+// and decodes the document into V.
+func (o *Object[T]) Scan(src interface{}) error {
+	raw, valid, err := scalar.ScanJSON(src, reflect.TypeOf(*o))
+	if err != nil {
+		return err
+	}
+	return o.decode(raw, valid)
+}
+
+// Value implements the driver Valuer interface.
+// V is passed as a JSON string, which json and jsonb columns accept.
+func (o Object[T]) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	raw, err := json.Marshal(o.V)
+	if err != nil {
+		return nil, err
+	}
+	return string(raw), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// null will be considered a null Object, and any other input
+// is decoded into V.
+func (o *Object[T]) UnmarshalJSON(data []byte) error {
+	return o.decode(data, !scalar.IsJSONNull(data))
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Object if the input is blank or "null",
+// and decode the input as a JSON document otherwise.
+func (o *Object[T]) UnmarshalText(text []byte) error {
+	raw, valid, err := scalar.UnmarshalJSONText(text, reflect.TypeOf(*o))
+	if err != nil {
+		return err
+	}
+	return o.decode(raw, valid)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Object is null, and V as a nested value otherwise.
+func (o Object[T]) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.V)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Object is null, and V as JSON otherwise.
+func (o Object[T]) MarshalText() ([]byte, error) {
+	if !o.Valid {
+		return []byte{}, nil
+	}
+	return json.Marshal(o.V)
+}
+
+// SetValid changes this Object's value and also sets it to be non-null.
+func (o *Object[T]) SetValid(v T) {
+	o.V = v
+	o.Valid = true
+}
+
+// Ptr returns a pointer to this Object's value, or a nil pointer if this Object is null.
+func (o Object[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return &o.V
+}
+
+// IsZero returns true for invalid Objects, for future omitempty support.
+// A non-null Object holding a zero value will not be considered zero.
+func (o Object[T]) IsZero() bool {
+	return !o.Valid
+}
+
+// decode replaces V with the JSON document raw, or with zero if invalid.
+// V is reset first so that fields absent from raw do not keep old values.
+func (o *Object[T]) decode(raw []byte, valid bool) error {
+	var v T
+	if valid {
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return err
+		}
+	}
+	o.V, o.Valid = v, valid
+	return nil
+}
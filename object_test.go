@@ -0,0 +1,93 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type firmware struct {
+	Version string   `json:"version"`
+	Build   int      `json:"build,omitempty"`
+	Flags   []string `json:"flags,omitempty"`
+}
+
+func TestObjectJSON(t *testing.T) {
+	type device struct {
+		ID int
+		FW Object[firmware]
+	}
+	data, err := json.Marshal(device{ID: 1, FW: ObjectFrom(firmware{Version: "2.1", Build: 7})})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"ID":1,"FW":{"version":"2.1","build":7}}`, "nested object json")
+
+	data, err = json.Marshal(device{ID: 2})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"ID":2,"FW":null}`, "null object json")
+
+	d := device{FW: ObjectFrom(firmware{Version: "old", Build: 1})}
+	err = json.Unmarshal([]byte(`{"ID":3,"FW":{"version":"3.0"}}`), &d)
+	maybePanic(err)
+	if !d.FW.Valid || d.FW.V.Version != "3.0" || d.FW.V.Build != 0 {
+		t.Errorf("object should be replaced, not merged: %+v", d.FW)
+	}
+
+	err = json.Unmarshal([]byte(`{"ID":4,"FW":null}`), &d)
+	maybePanic(err)
+	if d.FW.Valid || d.FW.V.Version != "" {
+		t.Errorf("null object json should be invalid and zero: %+v", d.FW)
+	}
+
+	if err = json.Unmarshal([]byte(`{"FW":[1]}`), &d); err == nil {
+		t.Error("expected error decoding an array into a struct")
+	}
+}
+
+func TestObjectSQL(t *testing.T) {
+	var o Object[firmware]
+	err := o.Scan([]byte(`{"version":"1.4","flags":["beta"]}`))
+	maybePanic(err)
+	if !o.Valid || o.V.Version != "1.4" || len(o.V.Flags) != 1 {
+		t.Errorf("bad object scan: %+v", o)
+	}
+	v, err := o.Value()
+	maybePanic(err)
+	if v != `{"version":"1.4","flags":["beta"]}` {
+		t.Errorf("bad object driver value: %#v", v)
+	}
+
+	for _, src := range []interface{}{nil, "null"} {
+		err = o.Scan(src)
+		maybePanic(err)
+		if o.Valid {
+			t.Errorf("scanned %v is valid, but should be invalid", src)
+		}
+	}
+	if v, _ = o.Value(); v != nil {
+		t.Errorf("null object driver value should be nil, not %#v", v)
+	}
+
+	if err = o.Scan(`{"version":`); err == nil {
+		t.Error("expected error scanning invalid JSON")
+	}
+
+	m := ObjectFrom(map[string]int{"a": 1})
+	v, err = m.Value()
+	maybePanic(err)
+	if v != `{"a":1}` {
+		t.Errorf("bad map object driver value: %#v", v)
+	}
+}
+
+func TestObjectPointers(t *testing.T) {
+	if ObjectFromPtr[firmware](nil).Valid {
+		t.Error("ObjectFromPtr(nil) is valid, but should be invalid")
+	}
+	fw := firmware{Version: "1"}
+	o := ObjectFromPtr(&fw)
+	if !o.Valid || o.Ptr().Version != "1" {
+		t.Errorf("bad ObjectFromPtr: %+v", o)
+	}
+	if (Object[firmware]{}).Ptr() != nil {
+		t.Error("null object Ptr should be nil")
+	}
+}
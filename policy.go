@@ -0,0 +1,28 @@
+package null
+
+import "github.com/conneqtech/null/internal/scalar"
+
+// Policy controls which JSON and text input is accepted in place of the
+// native form of a type. It is applied the same way by every type:
+// pass one to the UnmarshalJSONWith and UnmarshalTextWith methods,
+// or use it from the UnmarshalJSON method of your own wrapper type.
+// A custom Policy can be built by setting the fields individually.
+//
+// To decode a single field strictly, wrap its type:
+//
+//	type StrictInt struct{ null.Int }
+//
+//	func (i *StrictInt) UnmarshalJSON(data []byte) error {
+//		return i.UnmarshalJSONWith(data, null.StrictPolicy())
+//	}
+type Policy = scalar.Policy
+
+// StrictPolicy accepts only the native JSON kind of each type, and null.
+func StrictPolicy() Policy { return scalar.Strict }
+
+// LenientPolicy accepts numeric strings, bool strings and blank strings as null.
+func LenientPolicy() Policy { return scalar.Lenient }
+
+// DefaultPolicy is the Policy of the UnmarshalJSON and UnmarshalText methods.
+// It accepts numeric strings and blank strings as null, but not bool strings.
+func DefaultPolicy() Policy { return scalar.Default }
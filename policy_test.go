@@ -0,0 +1,145 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// lenientBool shows how a type can be given its own Policy.
+type lenientBool struct {
+	Bool
+}
+
+func (b *lenientBool) UnmarshalJSON(data []byte) error {
+	return b.UnmarshalJSONWith(data, LenientPolicy())
+}
+
+// strictTime is the Policy wrapper from the Policy docs, for a single field.
+type strictTime struct {
+	Time
+}
+
+func (tm *strictTime) UnmarshalJSON(data []byte) error {
+	return tm.UnmarshalJSONWith(data, StrictPolicy())
+}
+
+func TestPolicyStrict(t *testing.T) {
+	var i Int
+	if err := i.UnmarshalJSONWith(intStringJSON, StrictPolicy()); err == nil {
+		t.Error("strict: expected error for numeric string")
+	}
+	err := i.UnmarshalJSONWith(intJSON, StrictPolicy())
+	maybePanic(err)
+	assertInt(t, i, "strict int json")
+
+	var i8 Int8
+	if err = i8.UnmarshalJSONWith([]byte(`"12"`), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for numeric string")
+	}
+
+	var f Float
+	if err = f.UnmarshalJSONWith(floatBlankJSON, StrictPolicy()); err == nil {
+		t.Error("strict: expected error for blank string")
+	}
+
+	var tm Time
+	if err = tm.UnmarshalJSONWith([]byte(`""`), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for blank time")
+	}
+}
+
+func TestPolicyLenient(t *testing.T) {
+	var b Bool
+	err := b.UnmarshalJSONWith([]byte(`"true"`), LenientPolicy())
+	maybePanic(err)
+	assertBool(t, b, "lenient bool string json")
+
+	err = b.UnmarshalJSONWith([]byte(`""`), LenientPolicy())
+	maybePanic(err)
+	assertNullBool(t, b, "lenient blank bool json")
+
+	err = b.UnmarshalTextWith([]byte("1"), LenientPolicy())
+	maybePanic(err)
+	assertBool(t, b, "lenient bool text")
+
+	if err = b.UnmarshalTextWith([]byte("1"), DefaultPolicy()); err == nil {
+		t.Error("default: expected error for bool text 1")
+	}
+
+	var v Value[uint16]
+	err = v.UnmarshalJSONWith([]byte(`"16"`), LenientPolicy())
+	maybePanic(err)
+	if v.V != 16 || !v.Valid {
+		t.Errorf("lenient uint16 string json: %+v", v)
+	}
+
+	var tm Time
+	err = tm.UnmarshalJSONWith([]byte(`""`), LenientPolicy())
+	maybePanic(err)
+	assertNullTime(t, tm, "lenient blank time json")
+}
+
+func TestPolicyCustom(t *testing.T) {
+	p := Policy{BoolStrings: true}
+	var b Bool
+	err := b.UnmarshalJSONWith([]byte(`"false"`), p)
+	maybePanic(err)
+	if !b.Valid || b.Bool {
+		t.Errorf("custom bool string json: %+v", b)
+	}
+
+	var i Int
+	if err = i.UnmarshalJSONWith(intStringJSON, p); err == nil {
+		t.Error("custom: expected error for numeric string")
+	}
+	if err = i.UnmarshalJSONWith([]byte(`""`), p); err == nil {
+		t.Error("custom: expected error for blank string")
+	}
+}
+
+func TestPolicyPerType(t *testing.T) {
+	var doc struct {
+		Lenient lenientBool
+		Default Bool
+	}
+	err := json.Unmarshal([]byte(`{"Lenient":"true","Default":true}`), &doc)
+	maybePanic(err)
+	assertBool(t, doc.Lenient.Bool, "per type lenient bool")
+	assertBool(t, doc.Default, "per type default bool")
+
+	if err = json.Unmarshal([]byte(`{"Default":"true"}`), &doc); err == nil {
+		t.Error("default: expected error for bool string")
+	}
+}
+
+func TestPolicyPerField(t *testing.T) {
+	var doc struct {
+		Strict  strictTime
+		Default Time
+	}
+	err := json.Unmarshal([]byte(`{"Strict":"2012-12-21T21:21:21Z","Default":""}`), &doc)
+	maybePanic(err)
+	assertTime(t, doc.Strict.Time, "per field strict time")
+	assertNullTime(t, doc.Default, "per field default blank time")
+
+	if err = json.Unmarshal([]byte(`{"Strict":""}`), &doc); err == nil {
+		t.Error("strict: expected error for blank time")
+	}
+}
+
+func TestPolicyTimeText(t *testing.T) {
+	var tm Time
+	err := tm.UnmarshalTextWith([]byte(""), LenientPolicy())
+	maybePanic(err)
+	if tm.Valid {
+		t.Error("lenient blank time text", "is valid, but should be invalid")
+	}
+	if err = tm.UnmarshalTextWith([]byte(""), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for blank time text")
+	}
+	err = tm.UnmarshalTextWith([]byte("null"), StrictPolicy())
+	maybePanic(err)
+	if tm.Valid {
+		t.Error("strict null time text", "is valid, but should be invalid")
+	}
+}
@@ -2,14 +2,19 @@
 // with convenient support for JSON and text marshaling.
 // Types in this package will always encode to their null value if null.
 // Use the zero subpackage if you want zero values and null to be treated the same.
+//
+// MongoDB BSON support is enabled by the mgo and mongo build tags,
+// for the mgo and official driver packages respectively.
+// Without the mgo tag the types have no GetBSON and SetBSON methods, so mgo
+// stores them as plain structs of their fields and cannot read documents
+// written with the tag: build and test programs that use mgo with -tags mgo.
 package null
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
 	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // String is a nullable string. It supports SQL and JSON serialization.
@@ -53,23 +58,13 @@ func NewString(s string, valid bool) String {
 // It supports string and null input. Blank string input does not produce a null String.
 // It also supports unmarshalling a sql.NullString.
 func (s *String) UnmarshalJSON(data []byte) error {
+	return s.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (s *String) UnmarshalJSONWith(data []byte, p Policy) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case string:
-		s.String = x
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &s.NullString)
-	case nil:
-		s.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.String", reflect.TypeOf(v).Name())
-	}
-	s.Valid = err == nil
+	s.String, s.Valid, err = scalar.UnmarshalJSONPolicy[string](data, reflect.TypeOf(*s), p)
 	return err
 }
 
@@ -79,18 +74,7 @@ func (s String) MarshalJSON() ([]byte, error) {
 	if !s.Valid {
 		return []byte("null"), nil
 	}
-	return json.Marshal(s.String)
-}
-
-func (s *String) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s String) GetBSON() (interface{}, error) {
-	if !s.Valid {
-		return nil,nil
-	}
-	return s.String, nil
+	return scalar.MarshalJSON(s.String)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -99,15 +83,20 @@ func (s String) MarshalText() ([]byte, error) {
 	if !s.Valid {
 		return []byte{}, nil
 	}
-	return []byte(s.String), nil
+	return []byte(scalar.Format(s.String)), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *String) UnmarshalText(text []byte) error {
-	s.String = string(text)
-	s.Valid = s.String != ""
-	return nil
+	return s.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (s *String) UnmarshalTextWith(text []byte, p Policy) error {
+	var err error
+	s.String, s.Valid, err = scalar.UnmarshalTextPolicy[string](text, p)
+	return err
 }
 
 // SetValid changes this String's value and also sets it to be non-null.
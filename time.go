@@ -5,10 +5,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"github.com/globalsign/mgo/bson"
 	"reflect"
-	"strings"
 	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Time is a nullable time.Time. It supports SQL and JSON serialization.
@@ -18,21 +18,20 @@ type Time struct {
 	Valid bool
 }
 
+// JSONTime is a time.Time with no methods of its own.
+//
+// Deprecated: use LayoutTime to choose how a time is written as JSON.
 type JSONTime time.Time
 
 // Scan implements the Scanner interface.
+// It accepts time.Time values, timestamps in text form as strings and []byte
+// values, such as RFC 3339 or "2006-01-02 15:04:05" with an optional fraction
+// and zone, and int64 values as Unix seconds. Timestamps without a zone are
+// read in UTC. Use UnixMilli, or a LayoutTime with a ScanUnit, for integers
+// in other units.
 func (t *Time) Scan(value interface{}) error {
 	var err error
-	switch x := value.(type) {
-	case time.Time:
-		t.Time = x
-	case nil:
-		t.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("null: cannot scan type %T into null.Time: %v", value, value)
-	}
-	t.Valid = err == nil
+	t.Time, t.Valid, err = scalar.ScanTime(value, reflect.TypeOf(*t), scalar.RFC3339)
 	return err
 }
 
@@ -45,9 +44,10 @@ func (t Time) Value() (driver.Value, error) {
 }
 
 // NewTime creates a new Time.
+// The monotonic clock reading of t is removed, so Times compare by their wall clock.
 func NewTime(t time.Time, valid bool) Time {
 	return Time{
-		Time:  t,
+		Time:  t.Round(0),
 		Valid: valid,
 	}
 }
@@ -96,6 +96,12 @@ func (t Time) MarshalJSON() ([]byte, error) {
 // It supports string, object (e.g. pq.NullTime and friends)
 // and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times.
+func (t *Time) UnmarshalJSONWith(data []byte, p Policy) error {
 	var err error
 	var v interface{}
 	if err = json.Unmarshal(data, &v); err != nil {
@@ -103,19 +109,12 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	}
 	switch x := v.(type) {
 	case string:
-		dataString := string(data)
-		// Ignore null, like in the main JSON package.
-		if dataString == "null" {
+		if x == "" && p.EmptyStringNull {
+			t.Valid = false
 			return nil
 		}
-		// Fractional seconds are handled implicitly by Parse.
-		t.Time, err = time.Parse(`"`+time.RFC3339+`"`, dataString)
-		if err != nil {
-			if strings.HasSuffix(dataString, `+0000"`) {
-				dataString = dataString[0:len(dataString)-6] + `Z"`
-				return t.UnmarshalJSON([]byte(dataString))
-			}
-		}
+		// Fractional seconds and offsets without a colon, such as +0000, are accepted.
+		t.Time, err = scalar.RFC3339.Parse(x)
 	case map[string]interface{}:
 		ti, tiOK := x["Time"].(string)
 		valid, validOK := x["Valid"].(bool)
@@ -135,17 +134,6 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	return err
 }
 
-func (t *Time) SetBSON(raw bson.Raw) error {
-	return bson.Unmarshal(raw.Data, t)
-}
-
-func (t Time) GetBSON() (interface{}, error) {
-	if !t.Valid {
-		return nil, nil
-	}
-	return t.Time, nil
-}
-
 func (t Time) MarshalText() ([]byte, error) {
 	if !t.Valid {
 		return []byte("null"), nil
@@ -153,9 +141,17 @@ func (t Time) MarshalText() ([]byte, error) {
 	return t.Time.MarshalText()
 }
 
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Time if the input is blank or "null".
 func (t *Time) UnmarshalText(text []byte) error {
+	return t.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+// Only EmptyStringNull applies to times: without it, blank input is an error.
+func (t *Time) UnmarshalTextWith(text []byte, p Policy) error {
 	str := string(text)
-	if str == "" || str == "null" {
+	if str == "null" || str == "" && p.EmptyStringNull {
 		t.Valid = false
 		return nil
 	}
@@ -168,7 +164,7 @@ func (t *Time) UnmarshalText(text []byte) error {
 
 // SetValid changes this Time's value and sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
-	t.Time = v
+	t.Time = v.Round(0)
 	t.Valid = true
 }
 
@@ -185,3 +181,13 @@ func (t Time) Ptr() *time.Time {
 func (t Time) IsZero() bool {
 	return !t.Valid
 }
+
+// EqualAt reports whether t and other are both null, or both valid and the
+// same instant when truncated to precision, such as a time and the same time
+// read back from a database that stores microseconds.
+func (t Time) EqualAt(other Time, precision time.Duration) bool {
+	if !t.Valid || !other.Valid {
+		return t.Valid == other.Valid
+	}
+	return scalar.AtPrecision(t.Time, precision, false).Equal(scalar.AtPrecision(other.Time, precision, false))
+}
@@ -2,6 +2,7 @@ package null
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 )
@@ -152,7 +153,7 @@ func TestTimeScanValue(t *testing.T) {
 	}
 
 	var wrong Time
-	err = wrong.Scan(int64(42))
+	err = wrong.Scan(true)
 	if err == nil {
 		t.Error("expected error")
 	}
@@ -203,3 +204,60 @@ func assertNullTime(t *testing.T, ti Time, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestTimeEqualAt(t *testing.T) {
+	precise := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+
+	now := TimeFrom(time.Now())
+	if now != TimeFrom(now.Time) {
+		t.Error("TimeFrom should remove the monotonic clock reading")
+	}
+
+	stored := TimeFrom(precise.Truncate(time.Millisecond))
+	if !TimeFrom(precise).EqualAt(stored, time.Millisecond) {
+		t.Error("times should be equal at millisecond precision")
+	}
+	if TimeFrom(precise).EqualAt(stored, time.Microsecond) {
+		t.Error("times should not be equal at microsecond precision")
+	}
+	late := precise.Add(500 * time.Microsecond)
+	if TimeFrom(late).EqualAt(TimeFrom(late.Round(time.Millisecond)), time.Millisecond) {
+		t.Error("EqualAt should truncate, not round")
+	}
+	if !(Time{}).EqualAt(Time{}, time.Millisecond) || (Time{}).EqualAt(stored, time.Millisecond) {
+		t.Error("EqualAt should only consider null times equal to each other")
+	}
+}
+
+func TestTimeScanText(t *testing.T) {
+	for _, src := range []interface{}{
+		"2012-12-21T21:21:21Z",
+		"2012-12-21 21:21:21",
+		[]byte("2012-12-21 21:21:21"),
+		[]byte("2012-12-21T21:21:21.000"),
+		"2012-12-21 22:21:21+01:00",
+		"2012-12-21 22:21:21+01",
+		int64(1356124881),
+	} {
+		var ti Time
+		err := ti.Scan(src)
+		maybePanic(err)
+		if !ti.Valid || !ti.Time.Equal(timeValue) {
+			t.Errorf("%#v: bad scanned time: %v", src, ti.Time)
+		}
+	}
+
+	var date Time
+	err := date.Scan("2012-12-21")
+	maybePanic(err)
+	if !date.Time.Equal(time.Date(2012, 12, 21, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad scanned date: %v", date.Time)
+	}
+
+	var wrong Time
+	err = wrong.Scan([]byte("21/12/2012"))
+	if err == nil || strings.Count(err.Error(), "21/12/2012") != 1 {
+		t.Errorf("expected a descriptive error, got %v", err)
+	}
+	assertNullTime(t, wrong, "scanned bad text")
+}
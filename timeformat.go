@@ -0,0 +1,158 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// TimeFormat is how a LayoutTime is written as JSON and text, and which
+// layouts are accepted when reading it. Layouts are in time.Parse form:
+// Layout is written and read, and Accept lists further layouts that are read.
+type TimeFormat = scalar.TimeFormat
+
+// TimeLayout names the TimeFormat of a LayoutTime.
+// Implement it on an empty struct type to define your own:
+//
+//	type Compact struct{}
+//
+//	func (Compact) TimeFormat() null.TimeFormat {
+//		return null.TimeFormat{Layout: "20060102T150405Z0700"}
+//	}
+//
+//	var t null.LayoutTime[Compact]
+type TimeLayout interface {
+	TimeFormat() TimeFormat
+}
+
+// RFC3339 is the TimeLayout of Time: RFC 3339 in whole seconds,
+// such as "2012-12-21T21:21:21Z". Fractional seconds are accepted as input.
+type RFC3339 struct{}
+
+// TimeFormat implements TimeLayout.
+func (RFC3339) TimeFormat() TimeFormat { return scalar.RFC3339 }
+
+// RFC3339Nano is RFC 3339 with fractional seconds,
+// such as "2012-12-21T21:21:21.123Z", as time.Time marshals itself.
+type RFC3339Nano struct{}
+
+// TimeFormat implements TimeLayout.
+func (RFC3339Nano) TimeFormat() TimeFormat { return scalar.RFC3339Nano }
+
+// UTC is RFC3339Nano with every time converted to UTC, so the same instant
+// is always written the same way, whatever location a driver returns.
+type UTC struct{}
+
+// TimeFormat implements TimeLayout.
+func (UTC) TimeFormat() TimeFormat { return scalar.UTC }
+
+// MySQL is the layout MySQL writes DATETIME values in, "2006-01-02 15:04:05".
+// Fractional seconds are accepted as input. It has no zone, so times are
+// written on their own wall clock and read in UTC.
+type MySQL struct{}
+
+// TimeFormat implements TimeLayout.
+func (MySQL) TimeFormat() TimeFormat { return scalar.MySQL }
+
+// HTTP is the date layout of HTTP headers, "Mon, 02 Jan 2006 15:04:05 GMT".
+// Times are written in UTC. The obsolete RFC 850 and ANSI C forms are
+// accepted as input, like http.ParseTime.
+type HTTP struct{}
+
+// TimeFormat implements TimeLayout.
+func (HTTP) TimeFormat() TimeFormat { return scalar.HTTP }
+
+// LayoutTime is a nullable time that is written as JSON and text in the
+// format of its TimeLayout L, such as LayoutTime[RFC3339Nano] or
+// LayoutTime[MySQL]. If the format has a Location, times are converted
+// to it when they are scanned, decoded and written. It will marshal to null if null.
+// The embedded Time converts it to and from a Time. SQL and BSON store it
+// like Time, at the Precision of the format.
+type LayoutTime[L TimeLayout] struct {
+	Time
+}
+
+// NewLayoutTime creates a new LayoutTime.
+func NewLayoutTime[L TimeLayout](t time.Time, valid bool) LayoutTime[L] {
+	return LayoutTime[L]{Time: NewTime(t, valid)}
+}
+
+// LayoutTimeFrom creates a new LayoutTime that will always be valid.
+func LayoutTimeFrom[L TimeLayout](t time.Time) LayoutTime[L] {
+	return NewLayoutTime[L](t, true)
+}
+
+// LayoutTimeFromPtr creates a new LayoutTime that will be null if t is nil.
+func LayoutTimeFromPtr[L TimeLayout](t *time.Time) LayoutTime[L] {
+	return LayoutTime[L]{Time: TimeFromPtr(t)}
+}
+
+// Format returns the TimeFormat of L.
+func (t LayoutTime[L]) Format() TimeFormat {
+	var l L
+	return l.TimeFormat()
+}
+
+// Scan implements the Scanner interface, like Time.Scan.
+// Text is read in the layouts of the format first, and text without a zone
+// is read in its Location, as in JSON. int64 values are read as a number of
+// the ScanUnit of the format.
+// The time is converted to the Location of the format, if it has one.
+func (t *LayoutTime[L]) Scan(value interface{}) error {
+	f := t.Format()
+	var err error
+	t.Time.Time, t.Valid, err = scalar.ScanTime(value, reflect.TypeOf(*t), f)
+	t.Time.Time = f.Normalize(t.Time.Time)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed at the Precision of the format, if it has one.
+func (t LayoutTime[L]) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Format().Store(t.Time.Time), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string input in any layout the format accepts, and null input.
+func (t *LayoutTime[L]) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times.
+func (t *LayoutTime[L]) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalTimeJSON(data, reflect.TypeOf(*t), p, t.Format())
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null LayoutTime if the input is blank or "null".
+func (t *LayoutTime[L]) UnmarshalText(text []byte) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalTimeText(text, t.Format())
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this LayoutTime is null.
+func (t LayoutTime[L]) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalTimeJSON(t.Time.Time, t.Format())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this LayoutTime is null.
+func (t LayoutTime[L]) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.Format().Format(t.Time.Time)), nil
+}
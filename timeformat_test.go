@@ -0,0 +1,259 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var layoutRef = time.Date(2012, 12, 21, 21, 21, 21, 123000000, time.UTC)
+
+type compactLayout struct{}
+
+func (compactLayout) TimeFormat() TimeFormat {
+	return TimeFormat{Layout: "20060102T150405Z0700"}
+}
+
+func TestLayoutTimeJSON(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		want string
+	}{
+		{LayoutTimeFrom[RFC3339](layoutRef), `"2012-12-21T21:21:21Z"`},
+		{LayoutTimeFrom[RFC3339Nano](layoutRef), `"2012-12-21T21:21:21.123Z"`},
+		{LayoutTimeFrom[MySQL](layoutRef), `"2012-12-21 21:21:21"`},
+		{LayoutTimeFrom[HTTP](layoutRef.In(time.FixedZone("CET", 3600))), `"Fri, 21 Dec 2012 21:21:21 GMT"`},
+		{LayoutTimeFrom[compactLayout](layoutRef.Truncate(time.Second)), `"20121221T212121Z"`},
+		{LayoutTime[MySQL]{}, `null`},
+	}
+	for _, tc := range tests {
+		data, err := json.Marshal(tc.v)
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.want, "layout time json marshal")
+	}
+
+	var nano LayoutTime[RFC3339Nano]
+	err := json.Unmarshal([]byte(`"2012-12-21T21:21:21.123Z"`), &nano)
+	maybePanic(err)
+	if !nano.Valid || !nano.Time.Time.Equal(layoutRef) {
+		t.Errorf("bad rfc3339nano time: %v", nano.Time.Time)
+	}
+
+	var my LayoutTime[MySQL]
+	for _, in := range []string{`"2012-12-21 21:21:21.123"`, `"2012-12-21 21:21:21.123000"`} {
+		err = json.Unmarshal([]byte(in), &my)
+		maybePanic(err)
+		if !my.Valid || !my.Time.Time.Equal(layoutRef) || my.Time.Time.Location() != time.UTC {
+			t.Errorf("%s: bad mysql time: %v", in, my.Time.Time)
+		}
+	}
+	if err = json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &my); err == nil {
+		t.Error("mysql layout should reject RFC 3339 input")
+	}
+
+	var h LayoutTime[HTTP]
+	for _, in := range []string{`"Fri, 21 Dec 2012 21:21:21 GMT"`, `"Friday, 21-Dec-12 21:21:21 GMT"`, `"Fri Dec 21 21:21:21 2012"`} {
+		err = json.Unmarshal([]byte(in), &h)
+		maybePanic(err)
+		if !h.Valid || !h.Time.Time.Equal(layoutRef.Truncate(time.Second)) {
+			t.Errorf("%s: bad http time: %v", in, h.Time.Time)
+		}
+	}
+
+	err = json.Unmarshal(nullJSON, &my)
+	maybePanic(err)
+	if my.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	err = json.Unmarshal(blankStringJSON, &my)
+	maybePanic(err)
+	if my.Valid {
+		t.Error("blank string json", "is valid, but should be invalid")
+	}
+	for _, in := range []string{`1`, `"soon"`, `{}`} {
+		if err = json.Unmarshal([]byte(in), &my); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestLayoutTimeText(t *testing.T) {
+	lt := LayoutTimeFrom[MySQL](layoutRef)
+	data, err := lt.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2012-12-21 21:21:21", "layout time text marshal")
+	data, err = LayoutTime[MySQL]{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null layout time text marshal")
+
+	var unmarshal LayoutTime[MySQL]
+	err = unmarshal.UnmarshalText([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	if !unmarshal.Valid || !unmarshal.Time.Time.Equal(layoutRef.Truncate(time.Second)) {
+		t.Errorf("bad layout time: %v", unmarshal.Time.Time)
+	}
+	err = unmarshal.UnmarshalText(nullJSON)
+	maybePanic(err)
+	if unmarshal.Valid {
+		t.Error("null text", "is valid, but should be invalid")
+	}
+	if err = unmarshal.UnmarshalText([]byte("hello world")); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestTimeAcceptsOffsetWithoutColon(t *testing.T) {
+	var ti Time
+	err := json.Unmarshal([]byte(`"2012-12-21T21:21:21.123+0000"`), &ti)
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(layoutRef) {
+		t.Errorf("bad time: %v", ti.Time)
+	}
+}
+
+type cetLayout struct{}
+
+func (cetLayout) TimeFormat() TimeFormat {
+	f := RFC3339{}.TimeFormat()
+	f.Location = time.FixedZone("CET", 3600)
+	return f
+}
+
+type cetMySQLLayout struct{}
+
+func (cetMySQLLayout) TimeFormat() TimeFormat {
+	f := MySQL{}.TimeFormat()
+	f.Location = time.FixedZone("CET", 3600)
+	return f
+}
+
+func TestLayoutTimeScanLocation(t *testing.T) {
+	const in = "2012-12-21 21:21:21"
+	var scanned, decoded LayoutTime[cetMySQLLayout]
+	err := scanned.Scan(in)
+	maybePanic(err)
+	err = json.Unmarshal([]byte(`"`+in+`"`), &decoded)
+	maybePanic(err)
+	if !scanned.Time.Time.Equal(decoded.Time.Time) || scanned.Time.Time.Hour() != 21 {
+		t.Errorf("scanned %v and decoded %v should be the same instant", scanned.Time.Time, decoded.Time.Time)
+	}
+
+	var rfc LayoutTime[cetLayout]
+	err = rfc.Scan([]byte(in))
+	maybePanic(err)
+	if !rfc.Time.Time.Equal(decoded.Time.Time) {
+		t.Errorf("zoneless fallback text should be read in the format's location: %v", rfc.Time.Time)
+	}
+
+	var http LayoutTime[HTTP]
+	err = http.Scan("Fri, 21 Dec 2012 21:21:21 GMT")
+	maybePanic(err)
+	if !http.Valid || !http.Time.Time.Equal(timeValue) {
+		t.Errorf("http time should scan its own text form: %v", http.Time.Time)
+	}
+}
+
+func TestLayoutTimeLocation(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	local := time.Date(2012, 12, 21, 22, 21, 21, 0, cet)
+
+	data, err := json.Marshal(TimeFrom(local))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T22:21:21+01:00"`, "preserved location json marshal")
+	data, err = json.Marshal(LayoutTimeFrom[UTC](local))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21Z"`, "utc json marshal")
+	data, err = LayoutTimeFrom[UTC](local).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2012-12-21T21:21:21Z", "utc text marshal")
+
+	var scanned LayoutTime[UTC]
+	err = scanned.Scan(local)
+	maybePanic(err)
+	if scanned.Time.Time.Location() != time.UTC || !scanned.Time.Time.Equal(local) {
+		t.Errorf("scanned time should be normalized to UTC: %v", scanned.Time.Time)
+	}
+	var plain Time
+	err = plain.Scan(local)
+	maybePanic(err)
+	if plain.Time.Location() != cet {
+		t.Errorf("scanned Time should keep its location: %v", plain.Time)
+	}
+
+	var unmarshaled LayoutTime[cetLayout]
+	err = json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &unmarshaled)
+	maybePanic(err)
+	if unmarshaled.Time.Time.Location().String() != "CET" || unmarshaled.Time.Time.Hour() != 22 {
+		t.Errorf("unmarshaled time should be normalized to CET: %v", unmarshaled.Time.Time)
+	}
+	err = unmarshaled.UnmarshalText([]byte("2012-12-21T21:21:21Z"))
+	maybePanic(err)
+	if unmarshaled.Time.Time.Location().String() != "CET" {
+		t.Errorf("unmarshaled text should be normalized to CET: %v", unmarshaled.Time.Time)
+	}
+}
+
+type microLayout struct{}
+
+func (microLayout) TimeFormat() TimeFormat {
+	f := RFC3339Nano{}.TimeFormat()
+	f.Precision = time.Microsecond
+	return f
+}
+
+type roundedMilliLayout struct{}
+
+func (roundedMilliLayout) TimeFormat() TimeFormat {
+	f := RFC3339Nano{}.TimeFormat()
+	f.Precision, f.Round = time.Millisecond, true
+	return f
+}
+
+func TestLayoutTimePrecision(t *testing.T) {
+	precise := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+
+	v, err := LayoutTimeFrom[microLayout](precise).Value()
+	maybePanic(err)
+	if want := precise.Truncate(time.Microsecond); v != want {
+		t.Errorf("bad value at microsecond precision: %v ≠ %v", v, want)
+	}
+	v, err = TimeFrom(precise).Value()
+	maybePanic(err)
+	if v != precise {
+		t.Errorf("Time should keep its full precision: %v", v)
+	}
+	data, err := json.Marshal(LayoutTimeFrom[microLayout](precise))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21.123456Z"`, "microsecond json marshal")
+
+	data, err = json.Marshal(LayoutTimeFrom[roundedMilliLayout](precise))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21.123Z"`, "rounded millisecond json marshal")
+	data, err = LayoutTimeFrom[roundedMilliLayout](precise.Add(400 * time.Microsecond)).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "2012-12-21T21:21:21.124Z", "rounded millisecond text marshal")
+}
+
+type milliScanLayout struct{}
+
+func (milliScanLayout) TimeFormat() TimeFormat {
+	f := RFC3339Nano{}.TimeFormat()
+	f.ScanUnit = time.Millisecond
+	return f
+}
+
+func TestLayoutTimeScanUnit(t *testing.T) {
+	var millis LayoutTime[milliScanLayout]
+	err := millis.Scan(int64(1356124881123))
+	maybePanic(err)
+	if !millis.Valid || !millis.Time.Time.Equal(timeValue.Add(123*time.Millisecond)) {
+		t.Errorf("bad scanned millis: %v", millis.Time.Time)
+	}
+
+	var secs LayoutTime[RFC3339]
+	err = secs.Scan(int64(1356124881))
+	maybePanic(err)
+	if !secs.Valid || !secs.Time.Time.Equal(timeValue) {
+		t.Errorf("bad scanned seconds: %v", secs.Time.Time)
+	}
+}
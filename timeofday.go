@@ -0,0 +1,136 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// TimeOfDay is a nullable wall clock time without a date or zone,
+// for SQL TIME columns such as opening hours.
+// It marshals to JSON as "15:04:05", with a fraction of a second if it has one.
+// It does not consider midnight to be null.
+// It will decode to null, not midnight, if null.
+type TimeOfDay struct {
+	TimeOfDay civil.TimeOfDay
+	Valid     bool // Valid is true if TimeOfDay is not NULL
+}
+
+// NewTimeOfDay creates a new TimeOfDay
+func NewTimeOfDay(t civil.TimeOfDay, valid bool) TimeOfDay {
+	return TimeOfDay{
+		TimeOfDay: t,
+		Valid:     valid,
+	}
+}
+
+// TimeOfDayFrom creates a new TimeOfDay that will always be valid.
+func TimeOfDayFrom(t civil.TimeOfDay) TimeOfDay {
+	return NewTimeOfDay(t, true)
+}
+
+// TimeOfDayFromPtr creates a new TimeOfDay that will be null if t is nil.
+func TimeOfDayFromPtr(t *civil.TimeOfDay) TimeOfDay {
+	if t == nil {
+		return NewTimeOfDay(civil.TimeOfDay{}, false)
+	}
+	return NewTimeOfDay(*t, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise midnight.
+func (t TimeOfDay) ValueOrZero() civil.TimeOfDay {
+	if !t.Valid {
+		return civil.TimeOfDay{}
+	}
+	return t.TimeOfDay
+}
+
+// Add returns t plus d, wrapping around midnight, or null if t is null.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	if !t.Valid {
+		return TimeOfDay{}
+	}
+	return TimeOfDayFrom(t.TimeOfDay.Add(d))
+}
+
+// Scan implements the Scanner interface.
+// It accepts time.Time values, read on their own wall clock,
+// and "15:04:05" strings and []byte values with an optional fraction.
+func (t *TimeOfDay) Scan(value interface{}) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.ScanTimeOfDay(value, reflect.TypeOf(*t))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed as a "15:04:05" string, which TIME columns accept.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.TimeOfDay.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "15:04:05" string and null input.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times of day.
+func (t *TimeOfDay) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.UnmarshalTimeOfDayJSON(data, reflect.TypeOf(*t), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeOfDay if the input is blank or "null".
+// It will return an error if the input is not a time, blank, or "null".
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.UnmarshalTimeOfDayText(text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this TimeOfDay is null, and "15:04:05" otherwise.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.TimeOfDay.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this TimeOfDay is null.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(t.TimeOfDay.String()), nil
+}
+
+// SetValid changes this TimeOfDay's value and also sets it to be non-null.
+func (t *TimeOfDay) SetValid(v civil.TimeOfDay) {
+	t.TimeOfDay = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this TimeOfDay's value, or a nil pointer if this TimeOfDay is null.
+func (t TimeOfDay) Ptr() *civil.TimeOfDay {
+	if !t.Valid {
+		return nil
+	}
+	return &t.TimeOfDay
+}
+
+// IsZero returns true for invalid TimeOfDays, for future omitempty support.
+// A non-null TimeOfDay at midnight will not be considered zero.
+func (t TimeOfDay) IsZero() bool {
+	return !t.Valid
+}
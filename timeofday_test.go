@@ -0,0 +1,106 @@
+package null
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+var opening = civil.MustParseTimeOfDay("08:30:00")
+
+func TestTimeOfDayJSON(t *testing.T) {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`"08:30:00"`), &tod)
+	maybePanic(err)
+	if !tod.Valid || tod.TimeOfDay != opening {
+		t.Errorf("bad time of day json: %+v", tod)
+	}
+	data, err := json.Marshal(tod)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"08:30:00"`, "time of day json marshal")
+
+	data, err = json.Marshal(TimeOfDayFrom(civil.MustParseTimeOfDay("23:59:59.999999")))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"23:59:59.999999"`, "fractional time of day json marshal")
+
+	err = json.Unmarshal(nullJSON, &tod)
+	maybePanic(err)
+	if tod.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	data, err = json.Marshal(tod)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null time of day json marshal")
+
+	midnight := TimeOfDayFrom(civil.TimeOfDay{})
+	if !midnight.Valid {
+		t.Error("midnight should not be null")
+	}
+
+	for _, in := range []string{`"25:00:00"`, `"8:30"`, `830`, `true`} {
+		if err := json.Unmarshal([]byte(in), &tod); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestTimeOfDaySQL(t *testing.T) {
+	var tod TimeOfDay
+	for _, src := range []interface{}{
+		"08:30:00",
+		[]byte("08:30:00.000000"),
+		time.Date(0, 1, 1, 8, 30, 0, 0, time.UTC),
+	} {
+		err := tod.Scan(src)
+		maybePanic(err)
+		if !tod.Valid || tod.TimeOfDay != opening {
+			t.Errorf("bad time of day scan of %v: %+v", src, tod)
+		}
+	}
+	v, err := tod.Value()
+	maybePanic(err)
+	if v != "08:30:00" {
+		t.Errorf("bad time of day driver value: %#v", v)
+	}
+
+	err = tod.Scan(nil)
+	maybePanic(err)
+	if tod.Valid {
+		t.Error("scanned nil", "is valid, but should be invalid")
+	}
+	if err = tod.Scan("-838:59:59"); err == nil {
+		t.Error("expected error scanning a negative MySQL TIME")
+	}
+	in := "08:30:00.1234567891"
+	if err = tod.Scan(in); err == nil || strings.Count(err.Error(), in) != 1 {
+		t.Errorf("expected a descriptive error, got %v", err)
+	}
+}
+
+func TestTimeOfDayAdd(t *testing.T) {
+	got := TimeOfDayFrom(civil.MustParseTimeOfDay("22:00:00")).Add(3 * time.Hour)
+	if !got.Valid || got.TimeOfDay.String() != "01:00:00" {
+		t.Errorf("Add should wrap around midnight: %+v", got)
+	}
+	if (TimeOfDay{}).Add(time.Hour).Valid {
+		t.Error("Add to a null TimeOfDay should be null")
+	}
+}
+
+func TestTimeOfDayText(t *testing.T) {
+	var tod TimeOfDay
+	err := tod.UnmarshalText([]byte("08:30"))
+	maybePanic(err)
+	txt, err := tod.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "08:30:00", "time of day text")
+
+	err = tod.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if tod.Valid {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+}
@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Uint16 is a nullable uint16.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Uint16 struct {
 	Uint16 uint16
 	Valid  bool // Valid is true if Uint16 is not NULL
 }
 
+// NewUint16 creates a new Uint16
 func NewUint16(i uint16, valid bool) Uint16 {
 	return Uint16{
 		Uint16: i,
@@ -20,12 +23,12 @@ func NewUint16(i uint16, valid bool) Uint16 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Uint16From creates a new Uint16 that will always be valid.
 func Uint16From(i uint16) Uint16 {
 	return NewUint16(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
 func Uint16FromPtr(i *uint16) Uint16 {
 	if i == nil {
 		return NewUint16(0, false)
@@ -41,85 +44,75 @@ func (i Uint16) ValueOrZero() uint16 {
 	return i.Uint16
 }
 
-func (i *Uint16) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint16.
+func (i *Uint16) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Uint16)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseUint(str, 10, 16)
-		if err != nil {
-			i.Uint16 = uint16(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Uint16)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Uint16, i.Valid, err = scalar.Scan[uint16](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Uint16) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Uint16) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Uint16) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Uint16, nil
+	return scalar.DriverValue(i.Uint16)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Uint16.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint16) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Uint16, i.Valid, err = scalar.UnmarshalJSONPolicy[uint16](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Uint16 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Uint16) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint16) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseUint(str, 10, 16)
-	if err != nil {
-		i.Uint16 = uint16(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Uint16, i.Valid, err = scalar.UnmarshalTextPolicy[uint16](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint16 is null.
 func (i Uint16) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint16), 10)), nil
+	return scalar.MarshalJSON(i.Uint16)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint16 is null.
 func (i Uint16) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint16), 10)), nil
+	return []byte(scalar.Format(i.Uint16)), nil
 }
 
+// SetValid changes this Uint16's value and also sets it to be non-null.
 func (i *Uint16) SetValid(n uint16) {
 	i.Uint16 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
 func (i Uint16) Ptr() *uint16 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Uint16) Ptr() *uint16 {
 	return &i.Uint16
 }
 
+// IsZero returns true for invalid Uint16s, for future omitempty support.
+// A non-null Uint16 with a 0 value will not be considered zero.
 func (i Uint16) IsZero() bool {
 	return !i.Valid
 }
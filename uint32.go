@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Uint32 is a nullable uint32.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Uint32 struct {
 	Uint32 uint32
 	Valid  bool // Valid is true if Uint32 is not NULL
 }
 
+// NewUint32 creates a new Uint32
 func NewUint32(i uint32, valid bool) Uint32 {
 	return Uint32{
 		Uint32: i,
@@ -20,12 +23,12 @@ func NewUint32(i uint32, valid bool) Uint32 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Uint32From creates a new Uint32 that will always be valid.
 func Uint32From(i uint32) Uint32 {
 	return NewUint32(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
 func Uint32FromPtr(i *uint32) Uint32 {
 	if i == nil {
 		return NewUint32(0, false)
@@ -41,85 +44,75 @@ func (i Uint32) ValueOrZero() uint32 {
 	return i.Uint32
 }
 
-func (i *Uint32) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint32.
+func (i *Uint32) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Uint32)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseUint(str, 10, 32)
-		if err != nil {
-			i.Uint32 = uint32(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Uint32)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Uint32, i.Valid, err = scalar.Scan[uint32](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Uint32) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Uint32) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Uint32) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Uint32, nil
+	return scalar.DriverValue(i.Uint32)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Uint32.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint32) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Uint32, i.Valid, err = scalar.UnmarshalJSONPolicy[uint32](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Uint32 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Uint32) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint32) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseUint(str, 10, 32)
-	if err != nil {
-		i.Uint32 = uint32(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Uint32, i.Valid, err = scalar.UnmarshalTextPolicy[uint32](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint32 is null.
 func (i Uint32) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint32), 10)), nil
+	return scalar.MarshalJSON(i.Uint32)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint32 is null.
 func (i Uint32) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint32), 10)), nil
+	return []byte(scalar.Format(i.Uint32)), nil
 }
 
+// SetValid changes this Uint32's value and also sets it to be non-null.
 func (i *Uint32) SetValid(n uint32) {
 	i.Uint32 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
 func (i Uint32) Ptr() *uint32 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Uint32) Ptr() *uint32 {
 	return &i.Uint32
 }
 
+// IsZero returns true for invalid Uint32s, for future omitempty support.
+// A non-null Uint32 with a 0 value will not be considered zero.
 func (i Uint32) IsZero() bool {
 	return !i.Valid
 }
@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Uint64 is a nullable uint64.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Uint64 struct {
 	Uint64 uint64
 	Valid  bool // Valid is true if Uint64 is not NULL
 }
 
+// NewUint64 creates a new Uint64
 func NewUint64(i uint64, valid bool) Uint64 {
 	return Uint64{
 		Uint64: i,
@@ -20,12 +23,12 @@ func NewUint64(i uint64, valid bool) Uint64 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Uint64From creates a new Uint64 that will always be valid.
 func Uint64From(i uint64) Uint64 {
 	return NewUint64(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Uint64FromPtr creates a new Uint64 that be null if i is nil.
 func Uint64FromPtr(i *uint64) Uint64 {
 	if i == nil {
 		return NewUint64(0, false)
@@ -41,85 +44,78 @@ func (i Uint64) ValueOrZero() uint64 {
 	return i.Uint64
 }
 
-func (i *Uint64) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint64.
+func (i *Uint64) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Uint64)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseUint(str, 10, 64)
-		if err != nil {
-			i.Uint64 = uint64(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Uint64)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Uint64, i.Valid, err = scalar.Scan[uint64](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Uint64) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Uint64) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+// Values above math.MaxInt64 do not fit a driver int64 and are
+// passed as their decimal string, which NUMERIC and BIGINT UNSIGNED
+// columns accept.
+func (i Uint64) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Uint64, nil
+	return scalar.DriverValue(i.Uint64)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Uint64.
+func (i *Uint64) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint64) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Uint64, i.Valid, err = scalar.UnmarshalJSONPolicy[uint64](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Uint64 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Uint64) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint64) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseUint(str, 10, 64)
-	if err != nil {
-		i.Uint64 = uint64(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Uint64, i.Valid, err = scalar.UnmarshalTextPolicy[uint64](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint64 is null.
 func (i Uint64) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint64), 10)), nil
+	return scalar.MarshalJSON(i.Uint64)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint64 is null.
 func (i Uint64) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint64), 10)), nil
+	return []byte(scalar.Format(i.Uint64)), nil
 }
 
+// SetValid changes this Uint64's value and also sets it to be non-null.
 func (i *Uint64) SetValid(n uint64) {
 	i.Uint64 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Uint64's value, or a nil pointer if this Uint64 is null.
 func (i Uint64) Ptr() *uint64 {
 	if !i.Valid {
 		return nil
@@ -127,6 +123,8 @@ func (i Uint64) Ptr() *uint64 {
 	return &i.Uint64
 }
 
+// IsZero returns true for invalid Uint64s, for future omitempty support.
+// A non-null Uint64 with a 0 value will not be considered zero.
 func (i Uint64) IsZero() bool {
 	return !i.Valid
 }
@@ -1,18 +1,21 @@
 package null
 
 import (
-	"encoding/json"
-	"fmt"
-	"github.com/globalsign/mgo/bson"
+	"database/sql/driver"
 	"reflect"
-	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
+// Uint8 is a nullable uint8.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
 type Uint8 struct {
 	Uint8 uint8
 	Valid bool // Valid is true if Uint8 is not NULL
 }
 
+// NewUint8 creates a new Uint8
 func NewUint8(i uint8, valid bool) Uint8 {
 	return Uint8{
 		Uint8: i,
@@ -20,12 +23,12 @@ func NewUint8(i uint8, valid bool) Uint8 {
 	}
 }
 
-// IntFrom creates a new Int that will always be valid.
+// Uint8From creates a new Uint8 that will always be valid.
 func Uint8From(i uint8) Uint8 {
 	return NewUint8(i, true)
 }
 
-// IntFromPtr creates a new Int that be null if i is nil.
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
 func Uint8FromPtr(i *uint8) Uint8 {
 	if i == nil {
 		return NewUint8(0, false)
@@ -41,85 +44,75 @@ func (i Uint8) ValueOrZero() uint8 {
 	return i.Uint8
 }
 
-func (i *Uint8) UnmarshalJSON(data []byte) error {
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint8.
+func (i *Uint8) Scan(value interface{}) error {
 	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		err = json.Unmarshal(data, &i.Uint8)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		parsedInt, err := strconv.ParseUint(str, 10, 8)
-		if err != nil {
-			i.Uint8 = uint8(parsedInt)
-		}
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.Uint8)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type null.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = err == nil
+	i.Uint8, i.Valid, err = scalar.Scan[uint8](value, reflect.TypeOf(*i))
 	return err
 }
 
-func (s *Uint8) SetBSON(raw bson.Raw) error {
-	return s.UnmarshalJSON(raw.Data)
-}
-
-func (s Uint8) GetBSON() (interface{}, error) {
-	if !s.Valid {
+// Value implements the driver Valuer interface.
+func (i Uint8) Value() (driver.Value, error) {
+	if !i.Valid {
 		return nil, nil
 	}
-	return s.Uint8, nil
+	return scalar.DriverValue(i.Uint8)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Uint8.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint8) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	i.Uint8, i.Valid, err = scalar.UnmarshalJSONPolicy[uint8](data, reflect.TypeOf(*i), p)
+	return err
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-// It will unmarshal to a null Int if the input is a blank or not an integer.
-// It will return an error if the input is not an integer, blank, or "null".
+// It will unmarshal to a null Uint8 if the input is blank or "null".
+// It will return an error if the input is not a integer, blank, or "null".
 func (i *Uint8) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint8) UnmarshalTextWith(text []byte, p Policy) error {
 	var err error
-	parsedInt, err := strconv.ParseUint(str, 10, 8)
-	if err != nil {
-		i.Uint8 = uint8(parsedInt)
-	}
-	i.Valid = err == nil
+	i.Uint8, i.Valid, err = scalar.UnmarshalTextPolicy[uint8](text, p)
 	return err
 }
 
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Uint8 is null.
 func (i Uint8) MarshalJSON() ([]byte, error) {
 	if !i.Valid {
 		return []byte("null"), nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint8), 10)), nil
+	return scalar.MarshalJSON(i.Uint8)
 }
 
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Uint8 is null.
 func (i Uint8) MarshalText() ([]byte, error) {
 	if !i.Valid {
 		return []byte{}, nil
 	}
-	return []byte(strconv.FormatUint(uint64(i.Uint8), 10)), nil
+	return []byte(scalar.Format(i.Uint8)), nil
 }
 
+// SetValid changes this Uint8's value and also sets it to be non-null.
 func (i *Uint8) SetValid(n uint8) {
 	i.Uint8 = n
 	i.Valid = true
 }
 
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
 func (i Uint8) Ptr() *uint8 {
 	if !i.Valid {
 		return nil
@@ -127,6 +120,8 @@ func (i Uint8) Ptr() *uint8 {
 	return &i.Uint8
 }
 
+// IsZero returns true for invalid Uint8s, for future omitempty support.
+// A non-null Uint8 with a 0 value will not be considered zero.
 func (i Uint8) IsZero() bool {
 	return !i.Valid
 }
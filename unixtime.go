@@ -0,0 +1,261 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// UnixTime is a nullable time that is encoded as a number of seconds since
+// the Unix epoch, such as 1700000000, in JSON, text and SQL.
+// It accepts numeric strings and RFC 3339 timestamps as input too,
+// and scans from integer columns as well as SQL timestamps.
+// The embedded Time converts it to and from a Time, and BSON
+// stores it as a datetime like Time.
+type UnixTime struct {
+	Time
+}
+
+// NewUnixTime creates a new UnixTime.
+func NewUnixTime(t time.Time, valid bool) UnixTime {
+	return UnixTime{Time: NewTime(t, valid)}
+}
+
+// UnixTimeFrom creates a new UnixTime that will always be valid.
+func UnixTimeFrom(t time.Time) UnixTime {
+	return NewUnixTime(t, true)
+}
+
+// UnixTimeFromPtr creates a new UnixTime that will be null if t is nil.
+func UnixTimeFromPtr(t *time.Time) UnixTime {
+	return UnixTime{Time: TimeFromPtr(t)}
+}
+
+// Scan implements the Scanner interface.
+// Integers and floats are seconds since the Unix epoch,
+// and time.Time values are used as they are.
+func (t *UnixTime) Scan(value interface{}) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.ScanUnix(value, reflect.TypeOf(*t), time.Second)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed as an int64 number of seconds, for integer columns.
+// Use the embedded Time for timestamp columns.
+func (t UnixTime) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return scalar.UnixDriverValue(t.Time.Time, time.Second)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (t *UnixTime) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings and RFC 3339 strings both need p.NumberStrings.
+func (t *UnixTime) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixJSON(data, reflect.TypeOf(*t), p, time.Second)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UnixTime if the input is blank or "null".
+func (t *UnixTime) UnmarshalText(text []byte) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixText(text, time.Second)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixTime is null, and a number of seconds otherwise.
+func (t UnixTime) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Second)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this UnixTime is null.
+func (t UnixTime) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Second)), nil
+}
+
+// UnixMilli is a nullable time that is encoded as a number of milliseconds since
+// the Unix epoch, such as 1700000000000, in JSON, text and SQL.
+// It accepts numeric strings and RFC 3339 timestamps as input too,
+// and scans from integer columns as well as SQL timestamps.
+// The embedded Time converts it to and from a Time, and BSON
+// stores it as a datetime like Time.
+type UnixMilli struct {
+	Time
+}
+
+// NewUnixMilli creates a new UnixMilli.
+func NewUnixMilli(t time.Time, valid bool) UnixMilli {
+	return UnixMilli{Time: NewTime(t, valid)}
+}
+
+// UnixMilliFrom creates a new UnixMilli that will always be valid.
+func UnixMilliFrom(t time.Time) UnixMilli {
+	return NewUnixMilli(t, true)
+}
+
+// UnixMilliFromPtr creates a new UnixMilli that will be null if t is nil.
+func UnixMilliFromPtr(t *time.Time) UnixMilli {
+	return UnixMilli{Time: TimeFromPtr(t)}
+}
+
+// Scan implements the Scanner interface.
+// Integers and floats are milliseconds since the Unix epoch,
+// and time.Time values are used as they are.
+func (t *UnixMilli) Scan(value interface{}) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.ScanUnix(value, reflect.TypeOf(*t), time.Millisecond)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed as an int64 number of milliseconds, for integer columns.
+// Use the embedded Time for timestamp columns.
+func (t UnixMilli) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return scalar.UnixDriverValue(t.Time.Time, time.Millisecond)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (t *UnixMilli) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings and RFC 3339 strings both need p.NumberStrings.
+func (t *UnixMilli) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixJSON(data, reflect.TypeOf(*t), p, time.Millisecond)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UnixMilli if the input is blank or "null".
+func (t *UnixMilli) UnmarshalText(text []byte) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixText(text, time.Millisecond)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixMilli is null, and a number of milliseconds otherwise.
+func (t UnixMilli) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Millisecond)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this UnixMilli is null.
+func (t UnixMilli) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Millisecond)), nil
+}
+
+// UnixNano is a nullable time that is encoded as a number of nanoseconds since
+// the Unix epoch, such as 1700000000000000000, in JSON, text and SQL.
+// It accepts numeric strings and RFC 3339 timestamps as input too,
+// and scans from integer columns as well as SQL timestamps.
+// The embedded Time converts it to and from a Time, and BSON
+// stores it as a datetime like Time.
+type UnixNano struct {
+	Time
+}
+
+// NewUnixNano creates a new UnixNano.
+func NewUnixNano(t time.Time, valid bool) UnixNano {
+	return UnixNano{Time: NewTime(t, valid)}
+}
+
+// UnixNanoFrom creates a new UnixNano that will always be valid.
+func UnixNanoFrom(t time.Time) UnixNano {
+	return NewUnixNano(t, true)
+}
+
+// UnixNanoFromPtr creates a new UnixNano that will be null if t is nil.
+func UnixNanoFromPtr(t *time.Time) UnixNano {
+	return UnixNano{Time: TimeFromPtr(t)}
+}
+
+// Scan implements the Scanner interface.
+// Integers and floats are nanoseconds since the Unix epoch,
+// and time.Time values are used as they are.
+func (t *UnixNano) Scan(value interface{}) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.ScanUnix(value, reflect.TypeOf(*t), time.Nanosecond)
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed as an int64 number of nanoseconds, for integer columns.
+// Use the embedded Time for timestamp columns.
+func (t UnixNano) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return scalar.UnixDriverValue(t.Time.Time, time.Nanosecond)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (t *UnixNano) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings and RFC 3339 strings both need p.NumberStrings.
+func (t *UnixNano) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixJSON(data, reflect.TypeOf(*t), p, time.Nanosecond)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UnixNano if the input is blank or "null".
+func (t *UnixNano) UnmarshalText(text []byte) error {
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalUnixText(text, time.Nanosecond)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UnixNano is null, and a number of nanoseconds otherwise.
+func (t UnixNano) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Nanosecond)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this UnixNano is null.
+func (t UnixNano) MarshalText() ([]byte, error) {
+	if !t.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.FormatUnix(t.Time.Time, time.Nanosecond)), nil
+}
@@ -0,0 +1,123 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var unixRef = time.Date(2023, 11, 14, 22, 13, 20, 123456789, time.UTC)
+
+func TestUnixTimeJSON(t *testing.T) {
+	data, err := json.Marshal(UnixTimeFrom(unixRef))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1700000000", "unix seconds json marshal")
+	data, err = json.Marshal(UnixMilliFrom(unixRef))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1700000000123", "unix millis json marshal")
+	data, err = json.Marshal(UnixNanoFrom(unixRef))
+	maybePanic(err)
+	assertJSONEquals(t, data, "1700000000123456789", "unix nanos json marshal")
+	data, err = json.Marshal(UnixTime{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null unix json marshal")
+
+	var n UnixNano
+	err = json.Unmarshal([]byte("1700000000123456789"), &n)
+	maybePanic(err)
+	if !n.Valid || !n.Time.Time.Equal(unixRef) {
+		t.Errorf("unix nanos should not lose precision: %v", n.Time.Time)
+	}
+
+	var u UnixTime
+	for _, in := range []string{`1700000000`, `"1700000000"`, `"2023-11-14T22:13:20Z"`, `1.7e9`} {
+		err = json.Unmarshal([]byte(in), &u)
+		maybePanic(err)
+		if !u.Valid || u.Time.Time.Unix() != 1700000000 || u.Time.Time.Location() != time.UTC {
+			t.Errorf("%s: bad unix time %v", in, u.Time.Time)
+		}
+	}
+	var m UnixMilli
+	err = json.Unmarshal([]byte(`1700000000123.5`), &m)
+	maybePanic(err)
+	if m.Time.Time.Nanosecond() != 123500000 {
+		t.Errorf("fractional millis should keep their fraction: %v", m.Time.Time)
+	}
+
+	err = json.Unmarshal(nullJSON, &u)
+	maybePanic(err)
+	if u.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+	for _, in := range []string{`"1700000000"`, `"2023-11-14T22:13:20Z"`} {
+		if err = u.UnmarshalJSONWith([]byte(in), StrictPolicy()); err == nil {
+			t.Errorf("%s: strict policy should reject strings", in)
+		}
+	}
+	err = u.UnmarshalJSONWith([]byte(`1700000000`), StrictPolicy())
+	maybePanic(err)
+	for _, in := range []string{`true`, `"soon"`, `1e40`} {
+		if err = json.Unmarshal([]byte(in), &u); err == nil {
+			t.Errorf("%s: expected error", in)
+		}
+	}
+}
+
+func TestUnixTimeSQL(t *testing.T) {
+	var u UnixMilli
+	err := u.Scan(int64(1700000000123))
+	maybePanic(err)
+	if !u.Time.Time.Equal(unixRef.Truncate(time.Millisecond)) {
+		t.Errorf("bad unix millis scan: %v", u.Time.Time)
+	}
+	v, err := u.Value()
+	maybePanic(err)
+	if v != int64(1700000000123) {
+		t.Errorf("bad unix millis driver value: %#v", v)
+	}
+
+	err = u.Scan(unixRef)
+	maybePanic(err)
+	if !u.Time.Time.Equal(unixRef) {
+		t.Errorf("bad timestamp scan: %v", u.Time.Time)
+	}
+	err = u.Scan([]byte("2023-11-14 22:13:20"))
+	maybePanic(err)
+	if u.Time.Time.Unix() != 1700000000 {
+		t.Errorf("bad text timestamp scan: %v", u.Time.Time)
+	}
+
+	err = u.Scan(nil)
+	maybePanic(err)
+	if v, _ = u.Value(); v != nil {
+		t.Errorf("null driver value should be nil, not %#v", v)
+	}
+
+	var s UnixTime
+	err = s.Scan(int64(-1))
+	maybePanic(err)
+	if !s.Time.Time.Equal(time.Unix(-1, 0)) {
+		t.Errorf("bad negative unix time scan: %v", s.Time.Time)
+	}
+	data, err := json.Marshal(UnixTimeFrom(time.Unix(-1, 500)))
+	maybePanic(err)
+	assertJSONEquals(t, data, "-1", "negative unix time should round down")
+}
+
+func TestUnixTimeConvert(t *testing.T) {
+	tm := TimeFrom(unixRef)
+	u := UnixTime{Time: tm}
+	if u.Time != tm {
+		t.Error("UnixTime should convert to and from Time")
+	}
+	if !UnixMilliFromPtr(nil).IsZero() || UnixMilliFromPtr(&unixRef).Ptr() == nil {
+		t.Error("bad UnixMilliFromPtr")
+	}
+
+	var txt UnixTime
+	err := txt.UnmarshalText([]byte("1700000000"))
+	maybePanic(err)
+	out, err := txt.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, out, "1700000000", "unix time text")
+}
@@ -0,0 +1,123 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+	"github.com/conneqtech/null/uuid"
+)
+
+// UUID is a nullable uuid.UUID.
+// It does not consider the nil UUID to be null.
+// It will decode to null, not the nil UUID, if null.
+type UUID struct {
+	UUID  uuid.UUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// NewUUID creates a new UUID
+func NewUUID(u uuid.UUID, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID that will always be valid.
+func UUIDFrom(u uuid.UUID) UUID {
+	return NewUUID(u, true)
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil.
+func UUIDFromPtr(u *uuid.UUID) UUID {
+	if u == nil {
+		return NewUUID(uuid.Nil, false)
+	}
+	return NewUUID(*u, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the nil UUID.
+func (u UUID) ValueOrZero() uuid.UUID {
+	if !u.Valid {
+		return uuid.Nil
+	}
+	return u.UUID
+}
+
+// Scan implements the Scanner interface.
+// It accepts the text forms of a UUID as a string or []byte,
+// and its 16 raw bytes as a []byte.
+func (u *UUID) Scan(value interface{}) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.ScanUUID(value, reflect.TypeOf(*u))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The UUID is passed in canonical text form.
+func (u UUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, and every form accepted by uuid.Parse.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	return u.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (u *UUID) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.UnmarshalUUIDJSON(data, reflect.TypeOf(*u), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UUID if the input is blank or "null".
+func (u *UUID) UnmarshalText(text []byte) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.UnmarshalUUIDText(text)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this UUID is null, and the canonical form otherwise.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + u.UUID.String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this UUID is null.
+func (u UUID) MarshalText() ([]byte, error) {
+	if !u.Valid {
+		return []byte{}, nil
+	}
+	return []byte(u.UUID.String()), nil
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(v uuid.UUID) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// IsZero returns true for invalid UUIDs, for future omitempty support.
+// A non-null UUID holding the nil UUID will not be considered zero.
+func (u UUID) IsZero() bool {
+	return !u.Valid
+}
@@ -0,0 +1,114 @@
+// Package uuid provides a dependency-free RFC 4122 UUID,
+// the value type of null.UUID and zero.UUID.
+// UUID is a [16]byte, so values convert directly to and from
+// the UUID types of other packages that share that representation.
+package uuid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// UUID is a 128 bit universally unique identifier.
+type UUID [16]byte
+
+// Nil is the nil UUID, with all 128 bits set to zero.
+var Nil UUID
+
+// New returns a random (version 4) UUID.
+func New() (UUID, error) {
+	var u UUID
+	if _, err := rand.Read(u[:]); err != nil {
+		return Nil, err
+	}
+	u[6] = u[6]&0x0f | 0x40 // version 4
+	u[8] = u[8]&0x3f | 0x80 // RFC 4122 variant
+	return u, nil
+}
+
+// Parse parses a UUID in its canonical form
+// ("f47ac10b-58cc-4372-a567-0e02b2c3d479"), braced
+// ("{f47ac10b-58cc-4372-a567-0e02b2c3d479}"), as a URN
+// ("urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479"),
+// or as 32 hex digits without hyphens. Hex digits may be upper or lower case.
+func Parse(s string) (UUID, error) {
+	var u UUID
+	str := s
+	switch {
+	case len(str) == 45 && strings.EqualFold(str[:9], "urn:uuid:"):
+		str = str[9:]
+	case len(str) == 38 && str[0] == '{' && str[37] == '}':
+		str = str[1:37]
+	}
+	switch len(str) {
+	case 36:
+		if str[8] != '-' || str[13] != '-' || str[18] != '-' || str[23] != '-' {
+			return Nil, syntaxError(s)
+		}
+		str = str[:8] + str[9:13] + str[14:18] + str[19:23] + str[24:]
+	case 32:
+	default:
+		return Nil, syntaxError(s)
+	}
+	if _, err := hex.Decode(u[:], []byte(str)); err != nil {
+		return Nil, syntaxError(s)
+	}
+	return u, nil
+}
+
+// MustParse is like Parse, but panics if s is not a UUID.
+func MustParse(s string) UUID {
+	u, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// FromBytes returns the UUID held in the 16 bytes of b.
+func FromBytes(b []byte) (UUID, error) {
+	var u UUID
+	if len(b) != len(u) {
+		return Nil, fmt.Errorf("uuid: invalid length %d, want 16 bytes", len(b))
+	}
+	copy(u[:], b)
+	return u, nil
+}
+
+func syntaxError(s string) error {
+	return fmt.Errorf("uuid: invalid syntax: %q", s)
+}
+
+// String returns u in canonical form, such as
+// "f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// URN returns u as a URN, such as
+// "urn:uuid:f47ac10b-58cc-4372-a567-0e02b2c3d479".
+func (u UUID) URN() string {
+	return "urn:uuid:" + u.String()
+}
+
+// IsNil reports whether u is the nil UUID.
+func (u UUID) IsNil() bool {
+	return u == Nil
+}
+
+// Version returns the version number held in u.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
@@ -0,0 +1,66 @@
+package uuid
+
+import "testing"
+
+const canonical = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+
+func TestParse(t *testing.T) {
+	want := MustParse(canonical)
+	for _, in := range []string{
+		canonical,
+		"F47AC10B-58CC-4372-A567-0E02B2C3D479",
+		"{" + canonical + "}",
+		"urn:uuid:" + canonical,
+		"URN:UUID:" + canonical,
+		"f47ac10b58cc4372a5670e02b2c3d479",
+	} {
+		u, err := Parse(in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", in, err)
+			continue
+		}
+		if u != want {
+			t.Errorf("Parse(%q) = %v, want %v", in, u, want)
+		}
+	}
+
+	for _, in := range []string{
+		"",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d47",
+		"f47ac10b-58cc-4372-a567+0e02b2c3d479",
+		"{" + canonical,
+		"g47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"urn:uid:" + canonical,
+	} {
+		if _, err := Parse(in); err == nil {
+			t.Errorf("Parse(%q): expected error", in)
+		}
+	}
+
+	if want.String() != canonical || want.URN() != "urn:uuid:"+canonical {
+		t.Errorf("bad string forms: %s %s", want, want.URN())
+	}
+	if want.Version() != 4 || want.IsNil() || !Nil.IsNil() {
+		t.Error("bad version or nil check")
+	}
+}
+
+func TestNew(t *testing.T) {
+	a, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == b || a.Version() != 4 || a[8]&0xc0 != 0x80 {
+		t.Errorf("bad random UUIDs: %v %v", a, b)
+	}
+	if _, err = FromBytes(a[:15]); err == nil {
+		t.Error("FromBytes: expected error for 15 bytes")
+	}
+	if c, err := FromBytes(a[:]); err != nil || c != a {
+		t.Errorf("FromBytes = %v, %v", c, err)
+	}
+}
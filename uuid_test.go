@@ -0,0 +1,73 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conneqtech/null/uuid"
+)
+
+var (
+	uuidString = "f47ac10b-58cc-4372-a567-0e02b2c3d479"
+	uuidJSON   = []byte(`"` + uuidString + `"`)
+)
+
+func TestUUIDJSON(t *testing.T) {
+	var u UUID
+	err := json.Unmarshal([]byte(`"{F47AC10B-58CC-4372-A567-0E02B2C3D479}"`), &u)
+	maybePanic(err)
+	if !u.Valid || u.UUID.String() != uuidString {
+		t.Errorf("bad uuid json: %v", u.UUID)
+	}
+	data, err := json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, string(uuidJSON), "uuid json marshal")
+
+	err = json.Unmarshal([]byte(`"00000000-0000-0000-0000-000000000000"`), &u)
+	maybePanic(err)
+	if !u.Valid {
+		t.Error("nil uuid json", "is invalid, but should be valid")
+	}
+
+	err = json.Unmarshal(nullJSON, &u)
+	maybePanic(err)
+	data, err = json.Marshal(u)
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null uuid json marshal")
+
+	if err = json.Unmarshal([]byte(`"not-a-uuid"`), &u); err == nil {
+		t.Error("expected error for invalid uuid")
+	}
+	if err = json.Unmarshal(intJSON, &u); err == nil {
+		t.Error("expected error for number json")
+	}
+}
+
+func TestUUIDSQL(t *testing.T) {
+	want := uuid.MustParse(uuidString)
+	var u UUID
+	err := u.Scan(want[:])
+	maybePanic(err)
+	if u.UUID != want || !u.Valid {
+		t.Errorf("bad uuid scan of 16 bytes: %v", u.UUID)
+	}
+	err = u.Scan([]byte("urn:uuid:" + uuidString))
+	maybePanic(err)
+	if u.UUID != want {
+		t.Errorf("bad uuid scan of text bytes: %v", u.UUID)
+	}
+	v, err := u.Value()
+	maybePanic(err)
+	if v != uuidString {
+		t.Errorf("bad uuid driver value: %#v", v)
+	}
+	if err = u.Scan(int64(1)); err == nil {
+		t.Error("expected error for scanning an int64")
+	}
+
+	err = u.UnmarshalText([]byte(""))
+	maybePanic(err)
+	if u.Valid || u.Ptr() != nil {
+		t.Error("blank text", "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,138 @@
+package null
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Scalar is the set of types that Value can hold:
+// every integer, unsigned integer and float width, bool and string,
+// including named types derived from them.
+type Scalar interface {
+	scalar.Scalar
+}
+
+// Value is a nullable scalar of any width.
+// It does not consider zero values to be null.
+// It will decode to null, not zero, if null.
+// The sized types such as Int8 and Float32 share its implementation.
+type Value[T Scalar] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NewValue creates a new Value.
+func NewValue[T Scalar](v T, valid bool) Value[T] {
+	return Value[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// ValueFrom creates a new Value that will always be valid.
+func ValueFrom[T Scalar](v T) Value[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil.
+func ValueFromPtr[T Scalar](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (v Value[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.V
+}
+
+// Scan implements the Scanner interface.
+// Numeric input is range checked, so an out of range value is an error
+// rather than silently wrapping.
+func (v *Value[T]) Scan(src interface{}) error {
+	var err error
+	v.V, v.Valid, err = scalar.Scan[T](src, reflect.TypeOf(*v))
+	return err
+}
+
+// Value implements the driver Valuer interface.
+func (v Value[T]) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	return scalar.DriverValue(v.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will not be considered a null Value.
+// It also supports unmarshalling a sql.NullInt64 style object.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	return v.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (v *Value[T]) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	v.V, v.Valid, err = scalar.UnmarshalJSONPolicy[T](data, reflect.TypeOf(*v), p)
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Value if the input is blank or "null".
+func (v *Value[T]) UnmarshalText(text []byte) error {
+	return v.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (v *Value[T]) UnmarshalTextWith(text []byte, p Policy) error {
+	var err error
+	v.V, v.Valid, err = scalar.UnmarshalTextPolicy[T](text, p)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode null if this Value is null.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		return []byte("null"), nil
+	}
+	return scalar.MarshalJSON(v.V)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Value is null.
+func (v Value[T]) MarshalText() ([]byte, error) {
+	if !v.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Format(v.V)), nil
+}
+
+// SetValid changes this Value's value and also sets it to be non-null.
+func (v *Value[T]) SetValid(n T) {
+	v.V = n
+	v.Valid = true
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (v Value[T]) Ptr() *T {
+	if !v.Valid {
+		return nil
+	}
+	return &v.V
+}
+
+// IsZero returns true for invalid Values, for future omitempty support.
+// A non-null Value with a 0 value will not be considered zero.
+func (v Value[T]) IsZero() bool {
+	return !v.Valid
+}
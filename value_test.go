@@ -0,0 +1,315 @@
+package null
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestValueFrom(t *testing.T) {
+	v := ValueFrom[int8](-12)
+	if v.V != -12 || !v.Valid {
+		t.Errorf("ValueFrom(-12) = %+v", v)
+	}
+
+	zero := ValueFrom[uint16](0)
+	if !zero.Valid {
+		t.Error("ValueFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestValueFromPtr(t *testing.T) {
+	n := float32(1.5)
+	v := ValueFromPtr(&n)
+	if v.V != 1.5 || !v.Valid {
+		t.Errorf("ValueFromPtr(&1.5) = %+v", v)
+	}
+
+	null := ValueFromPtr[float32](nil)
+	if null.Valid {
+		t.Error("ValueFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestUnmarshalValue(t *testing.T) {
+	var i Value[int16]
+	err := json.Unmarshal([]byte(`-12345`), &i)
+	maybePanic(err)
+	if i.V != -12345 || !i.Valid {
+		t.Errorf("bad int16 json: %+v", i)
+	}
+
+	var si Value[int16]
+	err = json.Unmarshal([]byte(`"-12345"`), &si)
+	maybePanic(err)
+	if si.V != -12345 || !si.Valid {
+		t.Errorf("bad int16 string json: %+v", si)
+	}
+
+	var obj Value[int16]
+	err = json.Unmarshal([]byte(`{"Int16":12345,"Valid":true}`), &obj)
+	maybePanic(err)
+	if obj.V != 12345 || !obj.Valid {
+		t.Errorf("bad int16 object json: %+v", obj)
+	}
+
+	var nullObj Value[int16]
+	err = json.Unmarshal([]byte(`{"Int16":0,"Valid":false}`), &nullObj)
+	maybePanic(err)
+	if nullObj.Valid {
+		t.Error("null object json", "is valid, but should be invalid")
+	}
+
+	var null Value[int16]
+	err = json.Unmarshal(nullJSON, &null)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("null json", "is valid, but should be invalid")
+	}
+
+	var overflow Value[int16]
+	err = json.Unmarshal([]byte(`40000`), &overflow)
+	if err == nil {
+		t.Error("expected error for int16 overflow")
+	}
+	if overflow.Valid {
+		t.Error("overflowed int16", "is valid, but should be invalid")
+	}
+
+	var badString Value[uint8]
+	err = json.Unmarshal([]byte(`"abc"`), &badString)
+	if err == nil {
+		t.Error("expected error for non-numeric string")
+	}
+
+	var negative Value[uint32]
+	err = json.Unmarshal([]byte(`-1`), &negative)
+	if err == nil {
+		t.Error("expected error for negative uint32")
+	}
+
+	var badType Value[float32]
+	err = json.Unmarshal(boolJSON, &badType)
+	if _, ok := err.(*json.UnmarshalTypeError); !ok {
+		t.Errorf("expected json.UnmarshalTypeError, not %T", err)
+	}
+}
+
+func TestMarshalValue(t *testing.T) {
+	tests := []struct {
+		v    json.Marshaler
+		want string
+	}{
+		{ValueFrom[int8](-12), "-12"},
+		{ValueFrom[uint64](18446744073709551615), "18446744073709551615"},
+		{ValueFrom[float32](1.1), "1.1"},
+		{ValueFrom(true), "true"},
+		{ValueFrom(`"quoted"`), `"\"quoted\""`},
+		{Value[int32]{}, "null"},
+	}
+	for _, tc := range tests {
+		data, err := tc.v.MarshalJSON()
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.want, "value json marshal")
+	}
+}
+
+func TestValueText(t *testing.T) {
+	v := ValueFrom[int32](-7)
+	txt, err := v.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "-7", "value text marshal")
+
+	var u Value[int32]
+	err = u.UnmarshalText(txt)
+	maybePanic(err)
+	if u != v {
+		t.Errorf("text round trip: %+v ≠ %+v", u, v)
+	}
+
+	var null Value[int32]
+	err = null.UnmarshalText([]byte("null"))
+	maybePanic(err)
+	if null.Valid {
+		t.Error(`UnmarshalText() "null"`, "is valid, but should be invalid")
+	}
+
+	var bad Value[uint8]
+	if err = bad.UnmarshalText([]byte("256")); err == nil {
+		t.Error("expected error for uint8 overflow")
+	}
+}
+
+func TestValueScan(t *testing.T) {
+	var i Value[int8]
+	err := i.Scan(int64(-128))
+	maybePanic(err)
+	if i.V != -128 || !i.Valid {
+		t.Errorf("bad scanned int8: %+v", i)
+	}
+
+	var overflow Value[int8]
+	if err = overflow.Scan(int64(128)); err == nil {
+		t.Error("expected error for int8 overflow")
+	}
+	if overflow.Valid {
+		t.Error("overflowed int8", "is valid, but should be invalid")
+	}
+
+	var str Value[uint16]
+	err = str.Scan([]byte("65535"))
+	maybePanic(err)
+	if str.V != 65535 || !str.Valid {
+		t.Errorf("bad scanned uint16: %+v", str)
+	}
+
+	var null Value[uint16]
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+
+	val, err := ValueFrom[uint8](200).Value()
+	maybePanic(err)
+	if val != int64(200) {
+		t.Errorf("bad driver value: %#v", val)
+	}
+}
+
+func TestValuePointerAndZero(t *testing.T) {
+	v := ValueFrom[uint32](5)
+	if p := v.Ptr(); p == nil || *p != 5 {
+		t.Errorf("bad pointer: %v", p)
+	}
+	if v.IsZero() {
+		t.Error("IsZero() should be false")
+	}
+
+	var null Value[uint32]
+	if null.Ptr() != nil {
+		t.Error("Ptr() should be nil")
+	}
+	if !null.IsZero() {
+		t.Error("IsZero() should be true")
+	}
+	if null.ValueOrZero() != 0 {
+		t.Error("unexpected ValueOrZero", null.ValueOrZero())
+	}
+
+	null.SetValid(9)
+	if null.ValueOrZero() != 9 {
+		t.Error("unexpected ValueOrZero", null.ValueOrZero())
+	}
+}
+
+func TestSizedTypes(t *testing.T) {
+	// Sized types share Value's implementation, so they must agree on
+	// negative numbers and reject out of range input instead of dropping it.
+	var i8 Int8
+	err := json.Unmarshal([]byte(`-5`), &i8)
+	maybePanic(err)
+	data, err := json.Marshal(i8)
+	maybePanic(err)
+	assertJSONEquals(t, data, "-5", "int8 json marshal")
+
+	if err = json.Unmarshal([]byte(`"300"`), &i8); err == nil {
+		t.Error("expected error for int8 string overflow")
+	}
+	if i8.Valid {
+		t.Error("overflowed Int8", "is valid, but should be invalid")
+	}
+
+	var f32 Float32
+	err = json.Unmarshal([]byte(`"1.25"`), &f32)
+	maybePanic(err)
+	if f32.Float32 != 1.25 || !f32.Valid {
+		t.Errorf("bad float32 string json: %+v", f32)
+	}
+
+	var u64 Uint64
+	err = u64.UnmarshalText([]byte("18446744073709551615"))
+	maybePanic(err)
+	if u64.Uint64 != 18446744073709551615 || !u64.Valid {
+		t.Errorf("bad uint64 text: %+v", u64)
+	}
+}
+
+func TestSizedScan(t *testing.T) {
+	var _ sql.Scanner = &Int8{}
+	var _ driver.Valuer = Float32{}
+
+	var i16 Int16
+	err := i16.Scan(int64(-32768))
+	maybePanic(err)
+	if i16.Int16 != -32768 || !i16.Valid {
+		t.Errorf("bad scanned int16: %+v", i16)
+	}
+
+	// A value from a wider column must not wrap around.
+	var small Int16
+	if err = small.Scan(int64(40000)); err == nil {
+		t.Error("expected error for int16 overflow")
+	}
+	if small.Valid {
+		t.Error("overflowed Int16", "is valid, but should be invalid")
+	}
+
+	var u8 Uint8
+	if err = u8.Scan(int64(-1)); err == nil {
+		t.Error("expected error for negative uint8")
+	}
+
+	var u32 Uint32
+	err = u32.Scan("4294967295")
+	maybePanic(err)
+	if u32.Uint32 != 4294967295 || !u32.Valid {
+		t.Errorf("bad scanned uint32: %+v", u32)
+	}
+
+	var f32 Float32
+	err = f32.Scan(1.5)
+	maybePanic(err)
+	if f32.Float32 != 1.5 || !f32.Valid {
+		t.Errorf("bad scanned float32: %+v", f32)
+	}
+	if err = f32.Scan(math.MaxFloat64); err == nil {
+		t.Error("expected error for float32 overflow")
+	}
+
+	var null Int32
+	err = null.Scan(nil)
+	maybePanic(err)
+	if null.Valid {
+		t.Error("scanned null", "is valid, but should be invalid")
+	}
+	val, err := null.Value()
+	maybePanic(err)
+	if val != nil {
+		t.Errorf("null driver value should be nil, not %#v", val)
+	}
+}
+
+func TestUint64Value(t *testing.T) {
+	val, err := Uint64From(math.MaxInt64).Value()
+	maybePanic(err)
+	if val != int64(math.MaxInt64) {
+		t.Errorf("bad driver value: %#v", val)
+	}
+
+	// Values with the high bit set are passed as decimal strings.
+	val, err = Uint64From(math.MaxUint64).Value()
+	maybePanic(err)
+	if val != "18446744073709551615" {
+		t.Errorf("bad driver value: %#v", val)
+	}
+
+	var back Uint64
+	err = back.Scan(val)
+	maybePanic(err)
+	if back.Uint64 != math.MaxUint64 || !back.Valid {
+		t.Errorf("bad scanned uint64: %+v", back)
+	}
+}
@@ -2,10 +2,9 @@ package zero
 
 import (
 	"database/sql"
-	"encoding/json"
-	"errors"
-	"fmt"
 	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Bool is a nullable bool. False input is considered null.
@@ -38,49 +37,33 @@ func BoolFromPtr(b *bool) Bool {
 	return NewBool(*b, true)
 }
 
+// ValueOrZero returns the inner value if valid, otherwise false.
+func (b Bool) ValueOrZero() bool {
+	return b.Valid && b.Bool
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // "false" will be considered a null Bool.
 // It also supports unmarshalling a sql.NullBool.
 func (b *Bool) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case bool:
-		b.Bool = x
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &b.NullBool)
-	case nil:
-		b.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Bool", reflect.TypeOf(v).Name())
-	}
-	b.Valid = (err == nil) && b.Bool
-	return err
+	return b.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (b *Bool) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&b.Bool, &b.Valid, data, reflect.TypeOf(*b), p)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Bool if the input is a false or not a bool.
 // It will return an error if the input is not a float, blank, or "null".
 func (b *Bool) UnmarshalText(text []byte) error {
-	str := string(text)
-	switch str {
-	case "", "null":
-		b.Valid = false
-		return nil
-	case "true":
-		b.Bool = true
-	case "false":
-		b.Bool = false
-	default:
-		b.Valid = false
-		return errors.New("invalid input:" + str)
-	}
-	b.Valid = b.Bool
-	return nil
+	return b.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (b *Bool) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&b.Bool, &b.Valid, text, p)
 }
 
 // MarshalJSON implements json.Marshaler.
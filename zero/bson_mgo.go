@@ -0,0 +1,391 @@
+//go:build mgo
+
+package zero
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+	"github.com/globalsign/mgo/bson"
+)
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Bool.
+func (b *Bool) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&b.Bool, &b.Valid, raw, reflect.TypeOf(*b))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Bool is null or zero.
+func (b Bool) GetBSON() (interface{}, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(b.Bool)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and binary values of any subtype.
+// BSON null and empty binary values will be considered a null Bytes.
+func (b *Bytes) SetBSON(raw bson.Raw) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.DecodeBSONBytes(raw.Kind, raw.Data, reflect.TypeOf(*b))
+	b.Valid = b.Valid && len(b.Bytes) != 0
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Bytes is null or empty, and generic binary otherwise.
+func (b Bytes) GetBSON() (interface{}, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONBytes(b.Bytes)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, datetimes, read in UTC, and "2006-01-02" strings.
+// BSON null and the zero date will be considered a null Date.
+func (d *Date) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Date, d.Valid, err = scalar.DecodeBSONDate(raw.Kind, raw.Data, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Date.IsZero()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Date is null or zero, and a datetime at midnight UTC otherwise.
+func (d Date) GetBSON() (interface{}, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONDate(d.Date)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, decimal128, int32, int64 and double values.
+// BSON null and zero values will be considered a null Decimal.
+func (d *Decimal) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.DecodeBSONDecimal(raw.Kind, raw.Data, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Decimal.IsZero()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Decimal is null or zero, and a decimal128 otherwise.
+func (d Decimal) GetBSON() (interface{}, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDecimal(d.Decimal)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, a number of nanoseconds, or a duration string.
+// BSON null and zero values will be considered a null Duration.
+func (d *Duration) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.DecodeBSONDuration(raw.Kind, raw.Data, reflect.TypeOf(*d), time.Nanosecond)
+	d.Valid = d.Valid && d.Duration != 0
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Duration is null or zero, and a number of nanoseconds otherwise.
+func (d Duration) GetBSON() (interface{}, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration, time.Nanosecond)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, a number of seconds, or a duration string.
+// BSON null and zero values will be considered a null DurationSeconds.
+func (d *DurationSeconds) SetBSON(raw bson.Raw) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.DecodeBSONDuration(raw.Kind, raw.Data, reflect.TypeOf(*d), time.Second)
+	d.Valid = d.Valid && d.Duration.Duration != 0
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this DurationSeconds is null or zero, and a number of seconds otherwise.
+func (d DurationSeconds) GetBSON() (interface{}, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration.Duration, time.Second)
+	if err != nil {
+		return nil, err
+	}
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Float.
+func (f *Float) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&f.Float64, &f.Valid, raw, reflect.TypeOf(*f))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Float is null or zero.
+func (f Float) GetBSON() (interface{}, error) {
+	if f.IsZero() {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(f.Float64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Float32.
+func (f *Float32) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&f.Float32, &f.Valid, raw, reflect.TypeOf(*f))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Float32 is null or zero.
+func (f Float32) GetBSON() (interface{}, error) {
+	return f.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int.
+func (i *Int) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Int64, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int is null or zero.
+func (i Int) GetBSON() (interface{}, error) {
+	if i.IsZero() {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(i.Int64)
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int8.
+func (i *Int8) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Int8, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int8 is null or zero.
+func (i Int8) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int16.
+func (i *Int16) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Int16, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int16 is null or zero.
+func (i Int16) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int32.
+func (i *Int32) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Int32, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Int32 is null or zero.
+func (i Int32) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It supports BSON datetime and null values.
+// The time is converted to the Location of the format, if it has one.
+// The Null sentinel of the format will be considered a null LayoutTime.
+func (t *LayoutTime[L]) SetBSON(raw bson.Raw) error {
+	err := t.Time.SetBSON(raw)
+	t.normalize()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this LayoutTime is null or zero,
+// and the time at the Precision of its format otherwise.
+func (t LayoutTime[L]) GetBSON() (interface{}, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Format().Store(t.Time.Time), nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null String.
+func (s *String) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&s.String, &s.Valid, raw, reflect.TypeOf(*s))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this String is null or zero.
+func (s String) GetBSON() (interface{}, error) {
+	if s.IsZero() {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(s.String)
+}
+
+// SetBSON implements bson.Setter.
+// It supports BSON datetime and null values.
+// BSON null and the zero time will be considered a null Time.
+func (t *Time) SetBSON(raw bson.Raw) error {
+	var err error
+	t.Time, t.Valid, err = scalar.DecodeBSONTime(raw, reflect.TypeOf(*t))
+	t.Valid = t.Valid && !t.Time.IsZero()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Time is null or zero.
+func (t Time) GetBSON() (interface{}, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Time, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and "15:04:05" strings.
+// BSON null and midnight will be considered a null TimeOfDay.
+func (t *TimeOfDay) SetBSON(raw bson.Raw) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.DecodeBSONTimeOfDay(raw.Kind, raw.Data, reflect.TypeOf(*t))
+	t.Valid = t.Valid && !t.TimeOfDay.IsZero()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this TimeOfDay is null or midnight, and a "15:04:05" string otherwise.
+func (t TimeOfDay) GetBSON() (interface{}, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONTimeOfDay(t.TimeOfDay)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint8.
+func (i *Uint8) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Uint8, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint8 is null or zero.
+func (i Uint8) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint16.
+func (i *Uint16) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Uint16, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint16 is null or zero.
+func (i Uint16) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint32.
+func (i *Uint32) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Uint32, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint32 is null or zero.
+func (i Uint32) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint64.
+func (i *Uint64) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&i.Uint64, &i.Valid, raw, reflect.TypeOf(*i))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Uint64 is null or zero.
+func (i Uint64) GetBSON() (interface{}, error) {
+	return i.value().GetBSON()
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null and binary values of subtype 4.
+// BSON null and the nil UUID will be considered a null UUID.
+func (u *UUID) SetBSON(raw bson.Raw) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.DecodeBSONUUID(raw.Kind, raw.Data, reflect.TypeOf(*u))
+	u.Valid = u.Valid && !u.UUID.IsNil()
+	return err
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this UUID is null or the nil UUID, and binary subtype 4 otherwise.
+func (u UUID) GetBSON() (interface{}, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+	kind, data := scalar.EncodeBSONUUID(u.UUID)
+	return bson.Raw{Kind: kind, Data: data}, nil
+}
+
+// SetBSON implements bson.Setter.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Value.
+func (v *Value[T]) SetBSON(raw bson.Raw) error {
+	return decodeBSONNonZero(&v.V, &v.Valid, raw, reflect.TypeOf(*v))
+}
+
+// GetBSON implements bson.Getter.
+// It will encode null if this Value is null or zero.
+func (v Value[T]) GetBSON() (interface{}, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	return scalar.EncodeBSON(v.V)
+}
+
+// decodeBSONNonZero is the BSON counterpart of scanNonZero.
+func decodeBSONNonZero[T Scalar](v *T, valid *bool, raw bson.Raw, typ reflect.Type) error {
+	var err error
+	*v, *valid, err = scalar.DecodeBSON[T](raw, typ)
+	*valid = *valid && !scalar.IsZero(*v)
+	return err
+}
@@ -0,0 +1,70 @@
+//go:build mgo
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+)
+
+func TestBSONRoundTrip(t *testing.T) {
+	in := bsonDoc{
+		S:  StringFrom("test"),
+		I:  IntFrom(12345),
+		F:  FloatFrom(1.2345),
+		B:  BoolFrom(true),
+		T:  TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+		I8: Int8From(-8),
+		U:  ValueFrom[uint16](16),
+	}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var out bsonDoc
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.S != in.S || out.I != in.I || out.F != in.F || out.B != in.B || out.I8 != in.I8 || out.U != in.U {
+		t.Errorf("bad bson round trip: %+v ≠ %+v", out, in)
+	}
+	if !out.T.Time.Equal(in.T.Time) || !out.T.Valid {
+		t.Errorf("bad bson time round trip: %v ≠ %v", out.T, in.T)
+	}
+}
+
+func TestBSONZeroIsNull(t *testing.T) {
+	// Zero values are stored as null and read back as null.
+	in := bsonDoc{
+		S: NewString("", true),
+		I: NewInt(0, true),
+		T: NewTime(time.Time{}, true),
+	}
+	data, err := bson.Marshal(in)
+	maybePanic(err)
+
+	var raw bson.M
+	err = bson.Unmarshal(data, &raw)
+	maybePanic(err)
+	for _, key := range []string{"s", "i", "f", "b", "t", "i8", "u"} {
+		if v, ok := raw[key]; !ok || v != nil {
+			t.Errorf("bson key %q: expected null, got %#v", key, v)
+		}
+	}
+
+	out := bsonDoc{S: StringFrom("stale"), I: IntFrom(1)}
+	err = bson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.S.Valid || out.I.Valid || out.T.Valid {
+		t.Errorf("bson null should decode as null: %+v", out)
+	}
+
+	var fromZero struct{ I Int }
+	data, err = bson.Marshal(bson.M{"i": 0})
+	maybePanic(err)
+	err = bson.Unmarshal(data, &fromZero)
+	maybePanic(err)
+	if fromZero.I.Valid {
+		t.Error("bson zero", "is valid, but should be invalid")
+	}
+}
@@ -0,0 +1,389 @@
+//go:build mongo
+
+package zero
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+)
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Bool is null or zero.
+func (b Bool) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if b.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(b.Bool)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Bool.
+func (b *Bool) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&b.Bool, &b.Valid, byte(kind), data, reflect.TypeOf(*b))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Bytes is null or empty, and generic binary otherwise.
+func (b Bytes) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if b.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONBytes(b.Bytes)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and binary values of any subtype.
+// BSON null and empty binary values will be considered a null Bytes.
+func (b *Bytes) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.DecodeBSONBytes(byte(kind), data, reflect.TypeOf(*b))
+	b.Valid = b.Valid && len(b.Bytes) != 0
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Date is null or zero, and a datetime at midnight UTC otherwise.
+func (d Date) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if d.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONDate(d.Date)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, datetimes, read in UTC, and "2006-01-02" strings.
+// BSON null and the zero date will be considered a null Date.
+func (d *Date) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Date, d.Valid, err = scalar.DecodeBSONDate(byte(kind), data, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Date.IsZero()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Decimal is null or zero, and a decimal128 otherwise.
+func (d Decimal) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if d.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDecimal(d.Decimal)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, decimal128, int32, int64 and double values.
+// BSON null and zero values will be considered a null Decimal.
+func (d *Decimal) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.DecodeBSONDecimal(byte(kind), data, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Decimal.IsZero()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Duration is null or zero, and a number of nanoseconds otherwise.
+func (d Duration) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if d.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration, time.Nanosecond)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, a number of nanoseconds, or a duration string.
+// BSON null and zero values will be considered a null Duration.
+func (d *Duration) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.DecodeBSONDuration(byte(kind), data, reflect.TypeOf(*d), time.Nanosecond)
+	d.Valid = d.Valid && d.Duration != 0
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this DurationSeconds is null or zero, and a number of seconds otherwise.
+func (d DurationSeconds) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if d.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONDuration(d.Duration.Duration, time.Second)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, a number of seconds, or a duration string.
+// BSON null and zero values will be considered a null DurationSeconds.
+func (d *DurationSeconds) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.DecodeBSONDuration(byte(kind), data, reflect.TypeOf(*d), time.Second)
+	d.Valid = d.Valid && d.Duration.Duration != 0
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Float is null or zero.
+func (f Float) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if f.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(f.Float64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Float.
+func (f *Float) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&f.Float64, &f.Valid, byte(kind), data, reflect.TypeOf(*f))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Float32 is null or zero.
+func (f Float32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return f.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Float32.
+func (f *Float32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&f.Float32, &f.Valid, byte(kind), data, reflect.TypeOf(*f))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int is null or zero.
+func (i Int) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if i.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(i.Int64)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int.
+func (i *Int) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Int64, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int8 is null or zero.
+func (i Int8) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int8.
+func (i *Int8) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Int8, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int16 is null or zero.
+func (i Int16) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int16.
+func (i *Int16) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Int16, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Int32 is null or zero.
+func (i Int32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Int32.
+func (i *Int32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Int32, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this LayoutTime is null or zero,
+// and the time at the Precision of its format otherwise.
+func (t LayoutTime[L]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if t.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTime(t.Format().Store(t.Time.Time))
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It supports BSON datetime and null values.
+// The time is converted to the Location of the format, if it has one.
+// The Null sentinel of the format will be considered a null LayoutTime.
+func (t *LayoutTime[L]) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	err := t.Time.UnmarshalBSONValue(kind, data)
+	t.normalize()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this String is null or zero.
+func (s String) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if s.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(s.String)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null String.
+func (s *String) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&s.String, &s.Valid, byte(kind), data, reflect.TypeOf(*s))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Time is null or zero.
+func (t Time) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if t.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTime(t.Time)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It supports BSON datetime and null values.
+// BSON null and the zero time will be considered a null Time.
+func (t *Time) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	t.Time, t.Valid, err = scalar.DecodeBSONTimeValue(byte(kind), data, reflect.TypeOf(*t))
+	t.Valid = t.Valid && !t.Time.IsZero()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this TimeOfDay is null or midnight, and a "15:04:05" string otherwise.
+func (t TimeOfDay) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if t.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONTimeOfDay(t.TimeOfDay)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and "15:04:05" strings.
+// BSON null and midnight will be considered a null TimeOfDay.
+func (t *TimeOfDay) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.DecodeBSONTimeOfDay(byte(kind), data, reflect.TypeOf(*t))
+	t.Valid = t.Valid && !t.TimeOfDay.IsZero()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint8 is null or zero.
+func (i Uint8) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint8.
+func (i *Uint8) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Uint8, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint16 is null or zero.
+func (i Uint16) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint16.
+func (i *Uint16) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Uint16, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint32 is null or zero.
+func (i Uint32) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint32.
+func (i *Uint32) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Uint32, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Uint64 is null or zero.
+func (i Uint64) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return i.value().MarshalBSONValue()
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Uint64.
+func (i *Uint64) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&i.Uint64, &i.Valid, byte(kind), data, reflect.TypeOf(*i))
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this UUID is null or the nil UUID, and binary subtype 4 otherwise.
+func (u UUID) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if u.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data := scalar.EncodeBSONUUID(u.UUID)
+	return bsontype.Type(kind), data, nil
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null and binary values of subtype 4.
+// BSON null and the nil UUID will be considered a null UUID.
+func (u *UUID) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.DecodeBSONUUID(byte(kind), data, reflect.TypeOf(*u))
+	u.Valid = u.Valid && !u.UUID.IsNil()
+	return err
+}
+
+// MarshalBSONValue implements bsoncodec.ValueMarshaler from the official MongoDB driver.
+// It will encode null if this Value is null or zero.
+func (v Value[T]) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	if v.IsZero() {
+		return bsontype.Null, nil, nil
+	}
+	kind, data, err := scalar.EncodeBSONValue(v.V)
+	return bsontype.Type(kind), data, err
+}
+
+// UnmarshalBSONValue implements bsoncodec.ValueUnmarshaler from the official MongoDB driver.
+// It decodes BSON null, numeric, string and bool values according to their kind.
+// BSON null and zero values will be considered a null Value.
+func (v *Value[T]) UnmarshalBSONValue(kind bsontype.Type, data []byte) error {
+	return decodeBSONValueNonZero(&v.V, &v.Valid, byte(kind), data, reflect.TypeOf(*v))
+}
+
+// decodeBSONValueNonZero is the BSON counterpart of scanNonZero.
+func decodeBSONValueNonZero[T Scalar](v *T, valid *bool, kind byte, data []byte, typ reflect.Type) error {
+	var err error
+	*v, *valid, err = scalar.DecodeBSONValue[T](kind, data, typ)
+	*valid = *valid && !scalar.IsZero(*v)
+	return err
+}
@@ -0,0 +1,50 @@
+//go:build mongo
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	mongobson "go.mongodb.org/mongo-driver/bson"
+)
+
+func TestMongoDriverRoundTrip(t *testing.T) {
+	in := bsonDoc{
+		S:  StringFrom("test"),
+		I:  IntFrom(12345),
+		F:  FloatFrom(1.2345),
+		B:  BoolFrom(true),
+		T:  TimeFrom(time.Date(2012, 12, 21, 21, 21, 21, 0, time.UTC)),
+		I8: Int8From(-8),
+		U:  ValueFrom[uint16](16),
+	}
+	data, err := mongobson.Marshal(in)
+	maybePanic(err)
+
+	var out bsonDoc
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out != in {
+		t.Errorf("bad mongo driver round trip: %+v ≠ %+v", out, in)
+	}
+
+	// Zero values are stored as null and read back as null.
+	data, err = mongobson.Marshal(bsonDoc{I: NewInt(0, true)})
+	maybePanic(err)
+	var raw mongobson.M
+	err = mongobson.Unmarshal(data, &raw)
+	maybePanic(err)
+	if v, ok := raw["i"]; !ok || v != nil {
+		t.Errorf("bson key %q: expected null, got %#v", "i", v)
+	}
+
+	data, err = mongobson.Marshal(mongobson.M{"i": 0, "s": ""})
+	maybePanic(err)
+	out = bsonDoc{S: StringFrom("stale"), I: IntFrom(1)}
+	err = mongobson.Unmarshal(data, &out)
+	maybePanic(err)
+	if out.S.Valid || out.I.Valid {
+		t.Errorf("bson zero should decode as null: %+v", out)
+	}
+}
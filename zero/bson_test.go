@@ -0,0 +1,13 @@
+//go:build mgo || mongo
+
+package zero
+
+type bsonDoc struct {
+	S  String
+	I  Int
+	F  Float
+	B  Bool
+	T  Time
+	I8 Int8
+	U  Value[uint16]
+}
@@ -0,0 +1,209 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Bytes is a nullable []byte, for BLOB and bytea columns.
+// An empty slice will be considered null.
+// JSON marshals to a blank string if null.
+// Considered null to SQL if empty.
+// JSON and text use standard base64, like encoding/json does for []byte.
+// Use HexBytes or Base64URLBytes for other encodings.
+type Bytes struct {
+	Bytes []byte
+	Valid bool // Valid is true if Bytes is not NULL
+}
+
+// NewBytes creates a new Bytes
+func NewBytes(b []byte, valid bool) Bytes {
+	return Bytes{
+		Bytes: b,
+		Valid: valid,
+	}
+}
+
+// BytesFrom creates a new Bytes that will be null if b is empty.
+func BytesFrom(b []byte) Bytes {
+	return NewBytes(b, len(b) != 0)
+}
+
+// BytesFromPtr creates a new Bytes that will be null if b is nil.
+func BytesFromPtr(b *[]byte) Bytes {
+	if b == nil {
+		return NewBytes(nil, false)
+	}
+	return NewBytes(*b, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise nil.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
+// Scan implements the Scanner interface.
+// The bytes are copied, so it is safe to scan sql.RawBytes.
+// An empty value will be considered a null Bytes.
+func (b *Bytes) Scan(value interface{}) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.ScanBytes(value, reflect.TypeOf(*b))
+	b.Valid = b.Valid && len(b.Bytes) != 0
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Bytes is null or empty.
+func (b Bytes) Value() (driver.Value, error) {
+	if b.IsZero() {
+		return nil, nil
+	}
+	return b.Bytes, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports base64 string and null input.
+// A blank string will be considered a null Bytes.
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Base64)
+	b.Valid = b.Valid && len(b.Bytes) != 0
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Bytes if the input is blank.
+func (b *Bytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Base64)
+	b.Valid = b.Valid && len(b.Bytes) != 0
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string if this Bytes is null.
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	return scalar.MarshalBytesJSON(b.ValueOrZero(), scalar.Base64)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Bytes is null.
+func (b Bytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Base64.EncodeToString(b.Bytes)), nil
+}
+
+// SetValid changes this Bytes's value and also sets it to be non-null.
+func (b *Bytes) SetValid(v []byte) {
+	b.Bytes = v
+	b.Valid = true
+}
+
+// Ptr returns a pointer to this Bytes's value, or a nil pointer if this Bytes is null.
+func (b Bytes) Ptr() *[]byte {
+	if !b.Valid {
+		return nil
+	}
+	return &b.Bytes
+}
+
+// IsZero returns true for null or empty Bytes, for future omitempty support.
+func (b Bytes) IsZero() bool {
+	return !b.Valid || len(b.Bytes) == 0
+}
+
+// HexBytes is a Bytes that is encoded as lower case hexadecimal in JSON and text.
+type HexBytes struct {
+	Bytes
+}
+
+// HexBytesFrom creates a new HexBytes that will be null if b is empty.
+func HexBytesFrom(b []byte) HexBytes {
+	return HexBytes{Bytes: BytesFrom(b)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports hexadecimal string and null input.
+// A blank string will be considered a null HexBytes.
+func (b *HexBytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Hex)
+	b.Valid = b.Valid && len(b.Bytes.Bytes) != 0
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null HexBytes if the input is blank.
+func (b *HexBytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Hex)
+	b.Valid = b.Valid && len(b.Bytes.Bytes) != 0
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string if this HexBytes is null.
+func (b HexBytes) MarshalJSON() ([]byte, error) {
+	return scalar.MarshalBytesJSON(b.ValueOrZero(), scalar.Hex)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this HexBytes is null.
+func (b HexBytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Hex.EncodeToString(b.Bytes.Bytes)), nil
+}
+
+// Base64URLBytes is a Bytes that is encoded as unpadded URL-safe base64
+// in JSON and text. Padded input is accepted too.
+type Base64URLBytes struct {
+	Bytes
+}
+
+// Base64URLBytesFrom creates a new Base64URLBytes that will be null if b is empty.
+func Base64URLBytesFrom(b []byte) Base64URLBytes {
+	return Base64URLBytes{Bytes: BytesFrom(b)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports URL-safe base64 string and null input.
+// A blank string will be considered a null Base64URLBytes.
+func (b *Base64URLBytes) UnmarshalJSON(data []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesJSON(data, reflect.TypeOf(*b), scalar.Base64URL)
+	b.Valid = b.Valid && len(b.Bytes.Bytes) != 0
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Base64URLBytes if the input is blank.
+func (b *Base64URLBytes) UnmarshalText(text []byte) error {
+	var err error
+	b.Bytes.Bytes, b.Valid, err = scalar.UnmarshalBytesText(text, scalar.Base64URL)
+	b.Valid = b.Valid && len(b.Bytes.Bytes) != 0
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode a blank string if this Base64URLBytes is null.
+func (b Base64URLBytes) MarshalJSON() ([]byte, error) {
+	return scalar.MarshalBytesJSON(b.ValueOrZero(), scalar.Base64URL)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a blank string if this Base64URLBytes is null.
+func (b Base64URLBytes) MarshalText() ([]byte, error) {
+	if !b.Valid {
+		return []byte{}, nil
+	}
+	return []byte(scalar.Base64URL.EncodeToString(b.Bytes.Bytes)), nil
+}
@@ -0,0 +1,33 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	var b Bytes
+	err := json.Unmarshal(blankStringJSON, &b)
+	maybePanic(err)
+	if b.Valid {
+		t.Error("blank json", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(Bytes{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `""`, "null bytes json marshal")
+
+	err = b.Scan([]byte{})
+	maybePanic(err)
+	if b.Valid {
+		t.Error("empty scan", "is valid, but should be invalid")
+	}
+	v, err := BytesFrom([]byte{}).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("empty driver value should be nil, not %#v", v)
+	}
+
+	data, err = json.Marshal(HexBytesFrom([]byte{0xab}))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"ab"`, "hex bytes json marshal")
+}
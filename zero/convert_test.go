@@ -0,0 +1,24 @@
+package zero
+
+import "testing"
+
+func TestConvert(t *testing.T) {
+	i8, err := Convert[Int8](IntFrom(-12))
+	maybePanic(err)
+	if i8 != Int8From(-12) {
+		t.Errorf("Convert[Int8](-12) = %+v", i8)
+	}
+
+	zero, err := Convert[Int8](NewInt(0, true))
+	maybePanic(err)
+	if zero.Valid {
+		t.Error("converted zero", "is valid, but should be invalid")
+	}
+
+	if _, err = Convert[Uint8](IntFrom(256)); err == nil {
+		t.Error("expected error for uint8 overflow")
+	}
+	if _, ok := ConvertOK[Float32](FloatFrom(0.1)); ok {
+		t.Error("ConvertOK(0.1) to Float32 should not be ok")
+	}
+}
@@ -0,0 +1,149 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Date is a nullable calendar date without a time of day or zone,
+// for SQL DATE columns such as birthdates.
+// It marshals to JSON as "2006-01-02".
+// The zero date, 0001-01-01, will be considered null.
+// JSON marshals to the zero date if null.
+// Considered null to SQL if zero.
+type Date struct {
+	Date  civil.Date
+	Valid bool // Valid is true if Date is not NULL
+}
+
+// NewDate creates a new Date
+func NewDate(d civil.Date, valid bool) Date {
+	return Date{
+		Date:  d,
+		Valid: valid,
+	}
+}
+
+// DateFrom creates a new Date that will be null if d is zero.
+func DateFrom(d civil.Date) Date {
+	return NewDate(d, !d.IsZero())
+}
+
+// DateFromPtr creates a new Date that will be null if d is nil or zero.
+func DateFromPtr(d *civil.Date) Date {
+	if d == nil {
+		return NewDate(civil.Date{}, false)
+	}
+	return DateFrom(*d)
+}
+
+// DateFromTime creates a new Date holding the date of t in loc.
+// It will be null if t is null. If loc is nil, t's own location is used.
+func DateFromTime(t Time, loc *time.Location) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	if loc != nil {
+		return DateFrom(civil.DateOf(t.Time.In(loc)))
+	}
+	return DateFrom(civil.DateOf(t.Time))
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Date) ValueOrZero() civil.Date {
+	if !d.Valid {
+		return civil.Date{}
+	}
+	return d.Date
+}
+
+// TimeIn returns a Time at midnight at the start of this Date in loc,
+// or a null Time if this Date is null.
+func (d Date) TimeIn(loc *time.Location) Time {
+	if d.IsZero() {
+		return Time{}
+	}
+	return TimeFrom(d.Date.In(loc))
+}
+
+// Scan implements the Scanner interface.
+// It accepts time.Time values, read on their own wall clock,
+// and "2006-01-02" strings and []byte values.
+// The zero date will be considered a null Date.
+func (d *Date) Scan(value interface{}) error {
+	var err error
+	d.Date, d.Valid, err = scalar.ScanDate(value, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Date.IsZero()
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Date is null or zero,
+// and a "2006-01-02" string otherwise.
+func (d Date) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.Date.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "2006-01-02" string and null input.
+// The zero date will be considered a null Date.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to dates.
+func (d *Date) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Date, d.Valid, err = scalar.UnmarshalDateJSON(data, reflect.TypeOf(*d), p)
+	d.Valid = d.Valid && !d.Date.IsZero()
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Date if the input is blank, the zero date, or "null".
+// It will return an error if the input is not a date, blank, or "null".
+func (d *Date) UnmarshalText(text []byte) error {
+	var err error
+	d.Date, d.Valid, err = scalar.UnmarshalDateText(text)
+	d.Valid = d.Valid && !d.Date.IsZero()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0001-01-01" if this Date is null.
+func (d Date) MarshalJSON() ([]byte, error) {
+	return scalar.MarshalDateJSON(d.ValueOrZero()), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode "0001-01-01" if this Date is null.
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.ValueOrZero().String()), nil
+}
+
+// SetValid changes this Date's value and also sets it to be non-null.
+func (d *Date) SetValid(v civil.Date) {
+	d.Date = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Date's value, or a nil pointer if this Date is null.
+func (d Date) Ptr() *civil.Date {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Date
+}
+
+// IsZero returns true for null or zero Dates, for future omitempty support.
+func (d Date) IsZero() bool {
+	return !d.Valid || d.Date.IsZero()
+}
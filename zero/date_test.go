@@ -0,0 +1,40 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+func TestDate(t *testing.T) {
+	var d Date
+	err := json.Unmarshal([]byte(`"0001-01-01"`), &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("zero date", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(Date{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0001-01-01"`, "null date json marshal")
+
+	err = d.Scan(time.Time{})
+	maybePanic(err)
+	if d.Valid {
+		t.Error("scanned zero time", "is valid, but should be invalid")
+	}
+	v, err := DateFrom(civil.Date{}).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("zero date driver value should be nil, not %#v", v)
+	}
+
+	d = DateFrom(civil.NewDate(2024, time.May, 1))
+	if tm := d.TimeIn(time.UTC); !tm.Valid || tm.Time != time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("bad TimeIn: %v", tm.Time)
+	}
+	if DateFromTime(Time{}, time.UTC).Valid {
+		t.Error("DateFromTime of a null Time should be null")
+	}
+}
@@ -0,0 +1,180 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/decimal"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Decimal is a nullable exact decimal number. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Decimal struct {
+	Decimal decimal.Decimal
+	Valid   bool // Valid is true if Decimal is not NULL
+}
+
+// NewDecimal creates a new Decimal
+func NewDecimal(d decimal.Decimal, valid bool) Decimal {
+	return Decimal{
+		Decimal: d,
+		Valid:   valid,
+	}
+}
+
+// DecimalFrom creates a new Decimal that will be null if zero.
+func DecimalFrom(d decimal.Decimal) Decimal {
+	return NewDecimal(d, !d.IsZero())
+}
+
+// DecimalFromPtr creates a new Decimal that be null if d is nil.
+func DecimalFromPtr(d *decimal.Decimal) Decimal {
+	if d == nil {
+		return NewDecimal(decimal.Decimal{}, false)
+	}
+	return NewDecimal(*d, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Decimal) ValueOrZero() decimal.Decimal {
+	if !d.Valid {
+		return decimal.Decimal{}
+	}
+	return d.Decimal
+}
+
+// Scan implements the Scanner interface.
+// It accepts the string and []byte values drivers return for NUMERIC
+// columns, as well as integers and floats.
+// 0 will be considered a null Decimal.
+func (d *Decimal) Scan(value interface{}) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.ScanDecimal(value, reflect.TypeOf(*d))
+	d.Valid = d.Valid && !d.Decimal.IsZero()
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Decimal is null or zero,
+// and the decimal as a string otherwise.
+func (d Decimal) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return d.Decimal.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Decimal.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *Decimal) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.UnmarshalDecimalJSON(data, reflect.TypeOf(*d), p)
+	d.Valid = d.Valid && !d.Decimal.IsZero()
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Decimal if the input is blank, zero, or "null".
+// It will return an error if the input is not a number, blank, or "null".
+func (d *Decimal) UnmarshalText(text []byte) error {
+	var err error
+	d.Decimal, d.Valid, err = scalar.UnmarshalDecimalText(text)
+	d.Valid = d.Valid && !d.Decimal.IsZero()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Decimal is null.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.ValueOrZero().String()), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode 0 if this Decimal is null.
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.ValueOrZero().String()), nil
+}
+
+// SetValid changes this Decimal's value and also sets it to be non-null.
+func (d *Decimal) SetValid(v decimal.Decimal) {
+	d.Decimal = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Decimal's value, or a nil pointer if this Decimal is null.
+func (d Decimal) Ptr() *decimal.Decimal {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Decimal
+}
+
+// IsZero returns true for null or zero Decimals, for future omitempty support.
+func (d Decimal) IsZero() bool {
+	return !d.Valid || d.Decimal.IsZero()
+}
+
+// Add returns d + y. Null and zero are equivalent, so a null operand counts as 0.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Add(y Decimal) (Decimal, error) {
+	r, err := d.ValueOrZero().Add(y.ValueOrZero())
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Sub returns d - y. Null and zero are equivalent, so a null operand counts as 0.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Sub(y Decimal) (Decimal, error) {
+	r, err := d.ValueOrZero().Sub(y.ValueOrZero())
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Mul returns d × y. Null and zero are equivalent, so a null operand counts as 0.
+// It returns decimal.ErrExponentRange for numbers beyond decimal.MaxExponent.
+func (d Decimal) Mul(y Decimal) (Decimal, error) {
+	r, err := d.ValueOrZero().Mul(y.ValueOrZero())
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(r), nil
+}
+
+// Quo returns d / y rounded to the given number of decimal places.
+// Null and zero are equivalent, so dividing by a null Decimal
+// returns decimal.ErrDivisionByZero.
+func (d Decimal) Quo(y Decimal, places int, mode decimal.RoundingMode) (Decimal, error) {
+	q, err := d.ValueOrZero().Quo(y.ValueOrZero(), places, mode)
+	if err != nil {
+		return Decimal{}, err
+	}
+	return DecimalFrom(q), nil
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	return DecimalFrom(d.ValueOrZero().Neg())
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	return DecimalFrom(d.ValueOrZero().Abs())
+}
+
+// Round returns d rounded to the given number of decimal places.
+// See decimal.Decimal.Round.
+func (d Decimal) Round(places int, mode decimal.RoundingMode) Decimal {
+	return DecimalFrom(d.ValueOrZero().Round(places, mode))
+}
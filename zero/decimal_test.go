@@ -0,0 +1,41 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conneqtech/null/decimal"
+)
+
+func TestDecimal(t *testing.T) {
+	var d Decimal
+	err := json.Unmarshal([]byte(`0.00`), &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("zero json", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null decimal json marshal")
+
+	err = d.Scan("19.99")
+	maybePanic(err)
+	if d.Decimal.String() != "19.99" || !d.Valid {
+		t.Errorf("bad decimal scan: %v", d.Decimal)
+	}
+
+	sum, err := d.Add(Decimal{})
+	maybePanic(err)
+	if sum.Decimal.String() != "19.99" {
+		t.Errorf("Add with null = %v", sum.Decimal)
+	}
+	if _, err = d.Quo(Decimal{}, 2, decimal.HalfUp); err == nil {
+		t.Error("expected error for division by null")
+	}
+
+	v, err := DecimalFrom(decimal.New(0, -2)).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("zero driver value should be nil, not %#v", v)
+	}
+}
@@ -0,0 +1,224 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Duration is a nullable time.Duration. Zero input will be considered null.
+// JSON marshals to "0s" if null.
+// Considered null to SQL if zero.
+//
+// Input may be a Go duration string ("1h30m"), an ISO 8601 duration
+// ("PT1H30M"), a Postgres interval ("01:30:00") or an integer number of
+// nanoseconds. Duration marshals to a Go duration string; DurationISO
+// and DurationSeconds marshal to the other common forms.
+type Duration struct {
+	Duration time.Duration
+	Valid    bool // Valid is true if Duration is not NULL
+}
+
+// NewDuration creates a new Duration
+func NewDuration(d time.Duration, valid bool) Duration {
+	return Duration{
+		Duration: d,
+		Valid:    valid,
+	}
+}
+
+// DurationFrom creates a new Duration that will be null if d is zero.
+func DurationFrom(d time.Duration) Duration {
+	return NewDuration(d, d != 0)
+}
+
+// DurationFromPtr creates a new Duration that will be null if d is nil or zero.
+func DurationFromPtr(d *time.Duration) Duration {
+	if d == nil {
+		return NewDuration(0, false)
+	}
+	return DurationFrom(*d)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (d Duration) ValueOrZero() time.Duration {
+	if !d.Valid {
+		return 0
+	}
+	return d.Duration
+}
+
+// Scan implements the Scanner interface.
+// Integers are nanoseconds, as BIGINT columns written by Value hold them.
+// Strings may also hold a Postgres interval or any other accepted form.
+// 0 will be considered a null Duration.
+func (d *Duration) Scan(value interface{}) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.ScanDuration(value, reflect.TypeOf(*d), time.Nanosecond)
+	d.Valid = d.Valid && d.Duration != 0
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Duration is null or zero,
+// and an int64 number of nanoseconds otherwise.
+func (d Duration) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return int64(d.Duration), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Duration.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *Duration) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.UnmarshalDurationJSON(data, reflect.TypeOf(*d), p, time.Nanosecond)
+	d.Valid = d.Valid && d.Duration != 0
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Duration if the input is blank, zero, or "null".
+// It will return an error if the input is not a duration, blank, or "null".
+func (d *Duration) UnmarshalText(text []byte) error {
+	var err error
+	d.Duration, d.Valid, err = scalar.UnmarshalDurationText(text, time.Nanosecond)
+	d.Valid = d.Valid && d.Duration != 0
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0s" if this Duration is null.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.ValueOrZero().String())), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode "0s" if this Duration is null.
+func (d Duration) MarshalText() ([]byte, error) {
+	return []byte(d.ValueOrZero().String()), nil
+}
+
+// SetValid changes this Duration's value and also sets it to be non-null.
+func (d *Duration) SetValid(v time.Duration) {
+	d.Duration = v
+	d.Valid = true
+}
+
+// Ptr returns a pointer to this Duration's value, or a nil pointer if this Duration is null.
+func (d Duration) Ptr() *time.Duration {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Duration
+}
+
+// IsZero returns true for null or zero Durations, for future omitempty support.
+func (d Duration) IsZero() bool {
+	return !d.Valid || d.Duration == 0
+}
+
+// DurationISO is a nullable time.Duration that is encoded as an ISO 8601
+// duration, such as "PT1H30M", for JSON and text.
+// Zero input will be considered null, and null encodes as "PT0S".
+// Everything else, including its input and SQL, behaves like Duration.
+type DurationISO struct {
+	Duration
+}
+
+// DurationISOFrom creates a new DurationISO that will be null if d is zero.
+func DurationISOFrom(d time.Duration) DurationISO {
+	return DurationISO{Duration: DurationFrom(d)}
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "PT0S" if this DurationISO is null.
+func (d DurationISO) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + scalar.FormatISODuration(d.ValueOrZero()) + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode "PT0S" if this DurationISO is null.
+func (d DurationISO) MarshalText() ([]byte, error) {
+	return []byte(scalar.FormatISODuration(d.ValueOrZero())), nil
+}
+
+// DurationSeconds is a nullable time.Duration that is a number of seconds,
+// such as 5400 or 1.5, everywhere: in JSON, text, SQL and BSON.
+// It suits BIGINT columns and APIs that count timeouts in seconds.
+// Duration strings are accepted as input too.
+// Zero input will be considered null, and null encodes as 0.
+type DurationSeconds struct {
+	Duration
+}
+
+// DurationSecondsFrom creates a new DurationSeconds that will be null if d is zero.
+func DurationSecondsFrom(d time.Duration) DurationSeconds {
+	return DurationSeconds{Duration: DurationFrom(d)}
+}
+
+// Scan implements the Scanner interface.
+// Integers and floats are a number of seconds.
+// 0 will be considered a null DurationSeconds.
+func (d *DurationSeconds) Scan(value interface{}) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.ScanDuration(value, reflect.TypeOf(*d), time.Second)
+	d.Valid = d.Valid && d.Duration.Duration != 0
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this DurationSeconds is null or zero, and an int64
+// number of seconds, or a float64 if it is not whole, otherwise.
+func (d DurationSeconds) Value() (driver.Value, error) {
+	if d.IsZero() {
+		return nil, nil
+	}
+	return scalar.DurationDriverValue(d.Duration.Duration, time.Second), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input. Numbers are seconds.
+// 0 will be considered a null DurationSeconds.
+func (d *DurationSeconds) UnmarshalJSON(data []byte) error {
+	return d.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (d *DurationSeconds) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.UnmarshalDurationJSON(data, reflect.TypeOf(*d), p, time.Second)
+	d.Valid = d.Valid && d.Duration.Duration != 0
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null DurationSeconds if the input is blank, zero, or "null".
+func (d *DurationSeconds) UnmarshalText(text []byte) error {
+	var err error
+	d.Duration.Duration, d.Valid, err = scalar.UnmarshalDurationText(text, time.Second)
+	d.Valid = d.Valid && d.Duration.Duration != 0
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this DurationSeconds is null.
+func (d DurationSeconds) MarshalJSON() ([]byte, error) {
+	return []byte(scalar.FormatDuration(d.ValueOrZero(), time.Second)), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode 0 if this DurationSeconds is null.
+func (d DurationSeconds) MarshalText() ([]byte, error) {
+	return []byte(scalar.FormatDuration(d.ValueOrZero(), time.Second)), nil
+}
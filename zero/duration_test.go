@@ -0,0 +1,43 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestDuration(t *testing.T) {
+	var d Duration
+	err := json.Unmarshal([]byte(`"PT0S"`), &d)
+	maybePanic(err)
+	if d.Valid {
+		t.Error("zero duration", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(d)
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0s"`, "null duration json marshal")
+
+	err = json.Unmarshal([]byte(`"01:00:00"`), &d)
+	maybePanic(err)
+	if !d.Valid || d.Duration != time.Hour {
+		t.Errorf("bad duration json: %+v", d)
+	}
+
+	v, err := DurationFrom(0).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("zero duration driver value should be nil, not %#v", v)
+	}
+	err = d.Scan(int64(0))
+	maybePanic(err)
+	if d.Valid {
+		t.Error("scanned 0", "is valid, but should be invalid")
+	}
+
+	data, err = json.Marshal(DurationISO{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"PT0S"`, "null iso duration json marshal")
+	data, err = json.Marshal(DurationSeconds{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `0`, "null seconds duration json marshal")
+}
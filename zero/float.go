@@ -3,10 +3,11 @@ package zero
 import (
 	"database/sql"
 	"encoding/json"
-	"fmt"
 	"math"
 	"reflect"
 	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Float is a nullable float64. Zero input will be considered null.
@@ -39,51 +40,37 @@ func FloatFromPtr(f *float64) Float {
 	return NewFloat(*f, true)
 }
 
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Float.
 // It also supports unmarshalling a sql.NullFloat64.
 func (f *Float) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		f.Float64 = x
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			f.Valid = false
-			return nil
-		}
-		f.Float64, err = strconv.ParseFloat(str, 64)
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &f.NullFloat64)
-	case nil:
-		f.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Float", reflect.TypeOf(v).Name())
-	}
-	f.Valid = (err == nil) && (f.Float64 != 0)
-	return err
+	return f.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (f *Float) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&f.Float64, &f.Valid, data, reflect.TypeOf(*f), p)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Float if the input is a blank, zero, or not a float.
 // It will return an error if the input is not a float, blank, or "null".
 func (f *Float) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		f.Valid = false
-		return nil
-	}
-	var err error
-	f.Float64, err = strconv.ParseFloat(string(text), 64)
-	f.Valid = (err == nil) && (f.Float64 != 0)
-	return err
+	return f.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (f *Float) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&f.Float64, &f.Valid, text, p)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Float32 is a nullable float32. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Float32 struct {
+	Float32 float32
+	Valid   bool // Valid is true if Float32 is not NULL
+}
+
+// NewFloat32 creates a new Float32
+func NewFloat32(f float32, valid bool) Float32 {
+	return Float32{
+		Float32: f,
+		Valid:   valid,
+	}
+}
+
+// Float32From creates a new Float32 that will be null if zero.
+func Float32From(f float32) Float32 {
+	return NewFloat32(f, f != 0)
+}
+
+// Float32FromPtr creates a new Float32 that be null if f is nil.
+func Float32FromPtr(f *float32) Float32 {
+	if f == nil {
+		return NewFloat32(0, false)
+	}
+	return NewFloat32(*f, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (f Float32) ValueOrZero() float32 {
+	return f.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a float32.
+// 0 will be considered a null Float32.
+func (f *Float32) Scan(value interface{}) error {
+	return scanNonZero(&f.Float32, &f.Valid, value, reflect.TypeOf(*f))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Float32 is null or zero.
+func (f Float32) Value() (driver.Value, error) {
+	return f.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Float32.
+func (f *Float32) UnmarshalJSON(data []byte) error {
+	return f.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (f *Float32) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&f.Float32, &f.Valid, data, reflect.TypeOf(*f), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Float32 if the input is blank, zero, or "null".
+// It will return an error if the input is not a number, blank, or "null".
+func (f *Float32) UnmarshalText(text []byte) error {
+	return f.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (f *Float32) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&f.Float32, &f.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Float32 is null.
+func (f Float32) MarshalJSON() ([]byte, error) {
+	return f.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Float32 is null.
+func (f Float32) MarshalText() ([]byte, error) {
+	return f.value().MarshalText()
+}
+
+// SetValid changes this Float32's value and also sets it to be non-null.
+func (f *Float32) SetValid(n float32) {
+	f.Float32 = n
+	f.Valid = true
+}
+
+// Ptr returns a pointer to this Float32's value, or a nil pointer if this Float32 is null.
+func (f Float32) Ptr() *float32 {
+	return f.value().Ptr()
+}
+
+// IsZero returns true for null or zero Float32s, for future omitempty support.
+func (f Float32) IsZero() bool {
+	return f.value().IsZero()
+}
+
+// value returns this Float32 as a Value, which implements its methods.
+func (f Float32) value() Value[float32] {
+	return NewValue(f.Float32, f.Valid)
+}
@@ -189,6 +189,13 @@ func TestFloatInfNaN(t *testing.T) {
 	if err == nil {
 		t.Error("expected error for Inf, got nil")
 	}
+
+	for _, s := range []string{`"NaN"`, `"Inf"`, `"-Infinity"`, `"0x1p-2"`} {
+		var f Float
+		if err := json.Unmarshal([]byte(s), &f); err == nil {
+			t.Errorf("expected error for %s, got %v", s, f)
+		}
+	}
 }
 
 func assertFloat(t *testing.T, f Float, from string) {
@@ -2,10 +2,10 @@ package zero
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
 	"reflect"
 	"strconv"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Int is a nullable int64.
@@ -39,52 +39,37 @@ func IntFromPtr(i *int64) Int {
 	return n
 }
 
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports number and null input.
 // 0 will be considered a null Int.
 // It also supports unmarshalling a sql.NullInt64.
 func (i *Int) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case float64:
-		// Unmarshal again, directly to int64, to avoid intermediate float64
-		err = json.Unmarshal(data, &i.Int64)
-	case string:
-		str := string(x)
-		if len(str) == 0 {
-			i.Valid = false
-			return nil
-		}
-		i.Int64, err = strconv.ParseInt(str, 10, 64)
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &i.NullInt64)
-	case nil:
-		i.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.Int", reflect.TypeOf(v).Name())
-	}
-	i.Valid = (err == nil) && (i.Int64 != 0)
-	return err
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Int64, &i.Valid, data, reflect.TypeOf(*i), p)
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null Int if the input is a blank, zero, or not an integer.
 // It will return an error if the input is not an integer, blank, or "null".
 func (i *Int) UnmarshalText(text []byte) error {
-	str := string(text)
-	if str == "" || str == "null" {
-		i.Valid = false
-		return nil
-	}
-	var err error
-	i.Int64, err = strconv.ParseInt(string(text), 10, 64)
-	i.Valid = (err == nil) && (i.Int64 != 0)
-	return err
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Int64, &i.Valid, text, p)
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Int16 is a nullable int16. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Int16 struct {
+	Int16 int16
+	Valid bool // Valid is true if Int16 is not NULL
+}
+
+// NewInt16 creates a new Int16
+func NewInt16(i int16, valid bool) Int16 {
+	return Int16{
+		Int16: i,
+		Valid: valid,
+	}
+}
+
+// Int16From creates a new Int16 that will be null if zero.
+func Int16From(i int16) Int16 {
+	return NewInt16(i, i != 0)
+}
+
+// Int16FromPtr creates a new Int16 that be null if i is nil.
+func Int16FromPtr(i *int16) Int16 {
+	if i == nil {
+		return NewInt16(0, false)
+	}
+	return NewInt16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int16) ValueOrZero() int16 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int16.
+// 0 will be considered a null Int16.
+func (i *Int16) Scan(value interface{}) error {
+	return scanNonZero(&i.Int16, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Int16 is null or zero.
+func (i Int16) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Int16.
+func (i *Int16) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int16) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Int16, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int16 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Int16) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int16) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Int16, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int16 is null.
+func (i Int16) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int16 is null.
+func (i Int16) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Int16's value and also sets it to be non-null.
+func (i *Int16) SetValid(n int16) {
+	i.Int16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int16's value, or a nil pointer if this Int16 is null.
+func (i Int16) Ptr() *int16 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Int16s, for future omitempty support.
+func (i Int16) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Int16 as a Value, which implements its methods.
+func (i Int16) value() Value[int16] {
+	return NewValue(i.Int16, i.Valid)
+}
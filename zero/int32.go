@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Int32 is a nullable int32. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Int32 struct {
+	Int32 int32
+	Valid bool // Valid is true if Int32 is not NULL
+}
+
+// NewInt32 creates a new Int32
+func NewInt32(i int32, valid bool) Int32 {
+	return Int32{
+		Int32: i,
+		Valid: valid,
+	}
+}
+
+// Int32From creates a new Int32 that will be null if zero.
+func Int32From(i int32) Int32 {
+	return NewInt32(i, i != 0)
+}
+
+// Int32FromPtr creates a new Int32 that be null if i is nil.
+func Int32FromPtr(i *int32) Int32 {
+	if i == nil {
+		return NewInt32(0, false)
+	}
+	return NewInt32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int32) ValueOrZero() int32 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int32.
+// 0 will be considered a null Int32.
+func (i *Int32) Scan(value interface{}) error {
+	return scanNonZero(&i.Int32, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Int32 is null or zero.
+func (i Int32) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Int32.
+func (i *Int32) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int32) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Int32, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int32 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Int32) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int32) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Int32, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int32 is null.
+func (i Int32) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int32 is null.
+func (i Int32) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Int32's value and also sets it to be non-null.
+func (i *Int32) SetValid(n int32) {
+	i.Int32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int32's value, or a nil pointer if this Int32 is null.
+func (i Int32) Ptr() *int32 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Int32s, for future omitempty support.
+func (i Int32) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Int32 as a Value, which implements its methods.
+func (i Int32) value() Value[int32] {
+	return NewValue(i.Int32, i.Valid)
+}
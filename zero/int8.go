@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Int8 is a nullable int8. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Int8 struct {
+	Int8  int8
+	Valid bool // Valid is true if Int8 is not NULL
+}
+
+// NewInt8 creates a new Int8
+func NewInt8(i int8, valid bool) Int8 {
+	return Int8{
+		Int8:  i,
+		Valid: valid,
+	}
+}
+
+// Int8From creates a new Int8 that will be null if zero.
+func Int8From(i int8) Int8 {
+	return NewInt8(i, i != 0)
+}
+
+// Int8FromPtr creates a new Int8 that be null if i is nil.
+func Int8FromPtr(i *int8) Int8 {
+	if i == nil {
+		return NewInt8(0, false)
+	}
+	return NewInt8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Int8) ValueOrZero() int8 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a int8.
+// 0 will be considered a null Int8.
+func (i *Int8) Scan(value interface{}) error {
+	return scanNonZero(&i.Int8, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Int8 is null or zero.
+func (i Int8) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Int8.
+func (i *Int8) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Int8) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Int8, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Int8 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Int8) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Int8) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Int8, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Int8 is null.
+func (i Int8) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Int8 is null.
+func (i Int8) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Int8's value and also sets it to be non-null.
+func (i *Int8) SetValid(n int8) {
+	i.Int8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Int8's value, or a nil pointer if this Int8 is null.
+func (i Int8) Ptr() *int8 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Int8s, for future omitempty support.
+func (i Int8) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Int8 as a Value, which implements its methods.
+func (i Int8) value() Value[int8] {
+	return NewValue(i.Int8, i.Valid)
+}
@@ -0,0 +1,47 @@
+package zero
+
+import "github.com/conneqtech/null/internal/scalar"
+
+// IntString is a nullable int64 that is encoded as a JSON string,
+// such as "9007199254740993", so that JavaScript clients can read values
+// above 2^53 without losing precision. It accepts both numbers and strings
+// as input, like the ",string" struct tag option of encoding/json.
+// Zero input will be considered null. Everything else, including SQL
+// and text, behaves like Int.
+type IntString struct {
+	Int
+}
+
+// NewIntString creates a new IntString
+func NewIntString(i int64, valid bool) IntString {
+	return IntString{Int: NewInt(i, valid)}
+}
+
+// IntStringFrom creates a new IntString that will be null if i is zero.
+func IntStringFrom(i int64) IntString {
+	return IntString{Int: IntFrom(i)}
+}
+
+// IntStringFromPtr creates a new IntString that will be null if i is nil.
+func IntStringFromPtr(i *int64) IntString {
+	return IntString{Int: IntFromPtr(i)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (i *IntString) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings are always accepted.
+func (i *IntString) UnmarshalJSONWith(data []byte, p Policy) error {
+	p.NumberStrings = true
+	return i.Int.UnmarshalJSONWith(data, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0" if this IntString is null.
+func (i IntString) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + scalar.Format(i.ValueOrZero()) + `"`), nil
+}
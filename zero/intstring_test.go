@@ -0,0 +1,26 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIntString(t *testing.T) {
+	data, err := json.Marshal(IntStringFrom(1<<53 + 1))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"9007199254740993"`, "int string json marshal")
+
+	data, err = json.Marshal(Uint64String{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0"`, "null uint64 string json marshal")
+
+	var i IntString
+	err = json.Unmarshal([]byte(`"0"`), &i)
+	maybePanic(err)
+	if i.Valid {
+		t.Error("zero int string json", "is valid, but should be invalid")
+	}
+	err = json.Unmarshal(intJSON, &i)
+	maybePanic(err)
+	assertInt(t, i.Int, "int string from number json")
+}
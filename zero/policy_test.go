@@ -0,0 +1,75 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// strictInt is the Policy wrapper from the Policy docs, for a single field.
+type strictInt struct {
+	Int
+}
+
+func (i *strictInt) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, StrictPolicy())
+}
+
+func TestPolicy(t *testing.T) {
+	var b Bool
+	err := b.UnmarshalJSONWith([]byte(`"true"`), LenientPolicy())
+	maybePanic(err)
+	assertBool(t, b, "lenient bool string json")
+
+	err = b.UnmarshalJSONWith([]byte(`"false"`), LenientPolicy())
+	maybePanic(err)
+	assertNullBool(t, b, "lenient false bool string json")
+
+	if err = b.UnmarshalJSONWith([]byte(`"true"`), DefaultPolicy()); err == nil {
+		t.Error("default: expected error for bool string")
+	}
+
+	var i Int
+	if err = i.UnmarshalJSONWith(intStringJSON, StrictPolicy()); err == nil {
+		t.Error("strict: expected error for numeric string")
+	}
+	err = i.UnmarshalJSONWith(intStringJSON, LenientPolicy())
+	maybePanic(err)
+	assertInt(t, i, "lenient int string json")
+
+	var u Uint32
+	if err = u.UnmarshalJSONWith([]byte(`""`), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for blank string")
+	}
+}
+
+func TestPolicyPerField(t *testing.T) {
+	var doc struct {
+		Strict  strictInt
+		Default Int
+	}
+	err := json.Unmarshal([]byte(`{"Strict":12345,"Default":"12345"}`), &doc)
+	maybePanic(err)
+	assertInt(t, doc.Strict.Int, "per field strict int")
+	assertInt(t, doc.Default, "per field default int string")
+
+	if err = json.Unmarshal([]byte(`{"Strict":"12345"}`), &doc); err == nil {
+		t.Error("strict: expected error for numeric string")
+	}
+}
+
+func TestPolicyTimeText(t *testing.T) {
+	var tm Time
+	err := tm.UnmarshalTextWith([]byte(""), LenientPolicy())
+	maybePanic(err)
+	if tm.Valid {
+		t.Error("lenient blank time text", "is valid, but should be invalid")
+	}
+	if err = tm.UnmarshalTextWith([]byte(""), StrictPolicy()); err == nil {
+		t.Error("strict: expected error for blank time text")
+	}
+	err = tm.UnmarshalTextWith([]byte("null"), StrictPolicy())
+	maybePanic(err)
+	if tm.Valid {
+		t.Error("strict null time text", "is valid, but should be invalid")
+	}
+}
@@ -2,13 +2,19 @@
 // with convenient support for JSON and text marshaling.
 // Types in this package will JSON marshal to their zero value, even if null.
 // Use the null parent package if you don't want this.
+//
+// MongoDB BSON support is enabled by the mgo and mongo build tags,
+// for the mgo and official driver packages respectively.
+// Without the mgo tag the types have no GetBSON and SetBSON methods, so mgo
+// stores them as plain structs of their fields and cannot read documents
+// written with the tag: build and test programs that use mgo with -tags mgo.
 package zero
 
 import (
 	"database/sql"
-	"encoding/json"
-	"fmt"
 	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // String is a nullable string.
@@ -42,28 +48,24 @@ func StringFromPtr(s *string) String {
 	return NewString(*s, *s != "")
 }
 
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
 // UnmarshalJSON implements json.Unmarshaler.
 // It supports string and null input. Blank string input produces a null String.
 // It also supports unmarshalling a sql.NullString.
 func (s *String) UnmarshalJSON(data []byte) error {
-	var err error
-	var v interface{}
-	if err = json.Unmarshal(data, &v); err != nil {
-		return err
-	}
-	switch x := v.(type) {
-	case string:
-		s.String = x
-	case map[string]interface{}:
-		err = json.Unmarshal(data, &s.NullString)
-	case nil:
-		s.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("json: cannot unmarshal %v into Go value of type zero.String", reflect.TypeOf(v).Name())
-	}
-	s.Valid = (err == nil) && (s.String != "")
-	return err
+	return s.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (s *String) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&s.String, &s.Valid, data, reflect.TypeOf(*s), p)
 }
 
 // MarshalText implements encoding.TextMarshaler.
@@ -78,9 +80,12 @@ func (s String) MarshalText() ([]byte, error) {
 // UnmarshalText implements encoding.TextUnmarshaler.
 // It will unmarshal to a null String if the input is a blank string.
 func (s *String) UnmarshalText(text []byte) error {
-	s.String = string(text)
-	s.Valid = s.String != ""
-	return nil
+	return s.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (s *String) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&s.String, &s.Valid, text, p)
 }
 
 // SetValid changes this String's value and also sets it to be non-null.
@@ -187,3 +187,15 @@ func assertJSONEquals(t *testing.T, data []byte, cmp string, from string) {
 		t.Errorf("bad %s data: %s ≠ %s\n", from, data, cmp)
 	}
 }
+
+func TestStringValueOrZero(t *testing.T) {
+	valid := NewString("test", true)
+	if valid.ValueOrZero() != "test" {
+		t.Error("unexpected ValueOrZero", valid.ValueOrZero())
+	}
+
+	invalid := NewString("test", false)
+	if invalid.ValueOrZero() != "" {
+		t.Error("unexpected ValueOrZero", invalid.ValueOrZero())
+	}
+}
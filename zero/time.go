@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"reflect"
 	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
 )
 
 // Time is a nullable time.Time.
@@ -17,33 +19,50 @@ type Time struct {
 }
 
 // Scan implements Scanner interface.
+// It accepts time.Time values, timestamps in text form as strings and []byte
+// values, such as RFC 3339 or "2006-01-02 15:04:05" with an optional fraction
+// and zone, and int64 values as Unix seconds. Timestamps without a zone are
+// read in UTC. Use UnixMilli, or a LayoutTime with a ScanUnit, for integers
+// in other units.
+// The zero time and MySQL zero dates will be considered a null Time.
 func (t *Time) Scan(value interface{}) error {
-	var err error
+	return t.scan(value, reflect.TypeOf(*t), scalar.RFC3339)
+}
+
+// scan is Scan with text and int64 values read as f describes.
+// typ is the destination type reported in errors.
+func (t *Time) scan(value interface{}, typ reflect.Type, f scalar.TimeFormat) error {
 	switch x := value.(type) {
-	case time.Time:
-		t.Time = x
-	case nil:
-		t.Valid = false
-		return nil
-	default:
-		err = fmt.Errorf("null: cannot scan type %T into null.Time: %v", value, value)
+	case string:
+		if scalar.IsMySQLZeroDate(x) {
+			t.Valid = false
+			return nil
+		}
+	case []byte:
+		if scalar.IsMySQLZeroDate(string(x)) {
+			t.Valid = false
+			return nil
+		}
 	}
-	t.Valid = err == nil
+	var err error
+	t.Time, t.Valid, err = scalar.ScanTime(value, typ, f)
+	t.Valid = t.Valid && !t.Time.IsZero()
 	return err
 }
 
 // Value implements the driver Valuer interface.
 func (t Time) Value() (driver.Value, error) {
-	if !t.Valid {
+	if t.IsZero() {
 		return nil, nil
 	}
 	return t.Time, nil
 }
 
 // NewTime creates a new Time.
+// The monotonic clock reading of t is removed, so Times compare by their wall clock.
 func NewTime(t time.Time, valid bool) Time {
 	return Time{
-		Time:  t,
+		Time:  t.Round(0),
 		Valid: valid,
 	}
 }
@@ -63,11 +82,19 @@ func TimeFromPtr(t *time.Time) Time {
 	return TimeFrom(*t)
 }
 
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
 // MarshalJSON implements json.Marshaler.
 // It will encode the zero value of time.Time
-// if this time is invalid.
+// if this time is null.
 func (t Time) MarshalJSON() ([]byte, error) {
-	if !t.Valid {
+	if t.IsZero() {
 		return (time.Time{}).MarshalJSON()
 	}
 	return t.Time.MarshalJSON()
@@ -77,6 +104,12 @@ func (t Time) MarshalJSON() ([]byte, error) {
 // It supports string, object (e.g. pq.NullTime and friends)
 // and null input.
 func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times.
+func (t *Time) UnmarshalJSONWith(data []byte, p Policy) error {
 	var err error
 	var v interface{}
 	if err = json.Unmarshal(data, &v); err != nil {
@@ -84,6 +117,14 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 	}
 	switch x := v.(type) {
 	case string:
+		if x == "" && p.EmptyStringNull {
+			t.Valid = false
+			return nil
+		}
+		if scalar.IsMySQLZeroDate(x) {
+			t.Valid = false
+			return nil
+		}
 		var ti time.Time
 		if err = ti.UnmarshalJSON(data); err != nil {
 			return err
@@ -97,7 +138,7 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 			return fmt.Errorf(`json: unmarshalling object into Go value of type null.Time requires key "Time" to be of type string and key "Valid" to be of type bool; found %T and %T, respectively`, x["Time"], x["Valid"])
 		}
 		err = t.Time.UnmarshalText([]byte(ti))
-		t.Valid = valid
+		t.Valid = valid && !t.Time.IsZero()
 		return err
 	case nil:
 		t.Valid = false
@@ -108,30 +149,38 @@ func (t *Time) UnmarshalJSON(data []byte) error {
 }
 
 func (t Time) MarshalText() ([]byte, error) {
-	ti := t.Time
-	if !t.Valid {
-		ti = time.Time{}
+	if t.IsZero() {
+		return (time.Time{}).MarshalText()
 	}
-	return ti.MarshalText()
+	return t.Time.MarshalText()
 }
 
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Time if the input is blank, "null",
+// the zero time or a MySQL zero date.
 func (t *Time) UnmarshalText(text []byte) error {
+	return t.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+// Only EmptyStringNull applies to times: without it, blank input is an error.
+func (t *Time) UnmarshalTextWith(text []byte, p Policy) error {
 	str := string(text)
-	if str == "" || str == "null" {
+	if str == "null" || str == "" && p.EmptyStringNull || scalar.IsMySQLZeroDate(str) {
 		t.Valid = false
 		return nil
 	}
 	if err := t.Time.UnmarshalText(text); err != nil {
 		return err
 	}
-	t.Valid = true
+	t.Valid = !t.Time.IsZero()
 	return nil
 }
 
 // SetValid changes this Time's value and
 // sets it to be non-null.
 func (t *Time) SetValid(v time.Time) {
-	t.Time = v
+	t.Time = v.Round(0)
 	t.Valid = true
 }
 
@@ -148,3 +197,13 @@ func (t Time) Ptr() *time.Time {
 func (t Time) IsZero() bool {
 	return !t.Valid || t.Time.IsZero()
 }
+
+// EqualAt reports whether t and other are both null or zero, or the same
+// instant when truncated to precision, such as a time and the same time
+// read back from a database that stores microseconds.
+func (t Time) EqualAt(other Time, precision time.Duration) bool {
+	if t.IsZero() || other.IsZero() {
+		return t.IsZero() == other.IsZero()
+	}
+	return scalar.AtPrecision(t.Time, precision, false).Equal(scalar.AtPrecision(other.Time, precision, false))
+}
@@ -169,7 +169,7 @@ func TestTimeScan(t *testing.T) {
 	assertNullTime(t, null, "scanned null")
 
 	var wrong Time
-	err = wrong.Scan(int64(42))
+	err = wrong.Scan(true)
 	if err == nil {
 		t.Error("expected error")
 	}
@@ -224,3 +224,44 @@ func assertNullTime(t *testing.T, ti Time, from string) {
 		t.Error(from, "is valid, but should be invalid")
 	}
 }
+
+func TestTimeEqualAt(t *testing.T) {
+	precise := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+	if !TimeFrom(precise).EqualAt(TimeFrom(precise.Truncate(time.Millisecond)), time.Millisecond) {
+		t.Error("times should be equal at millisecond precision")
+	}
+	if !(Time{}).EqualAt(NewTime(time.Time{}, true), time.Millisecond) {
+		t.Error("null and zero times should be equal")
+	}
+}
+
+func TestTimeMySQLZeroDate(t *testing.T) {
+	for _, src := range []interface{}{"0000-00-00 00:00:00", []byte("0000-00-00 00:00:00"), []byte("0000-00-00")} {
+		ti := TimeFrom(timeValue)
+		err := ti.Scan(src)
+		maybePanic(err)
+		assertNullTime(t, ti, "scanned mysql zero date")
+	}
+	var ti Time
+	err := json.Unmarshal([]byte(`"0000-00-00T00:00:00Z"`), &ti)
+	maybePanic(err)
+	assertNullTime(t, ti, "mysql zero date json")
+}
+
+func TestTimeScanText(t *testing.T) {
+	var ti Time
+	err := ti.Scan([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	assertTime(t, ti, "scanned bytes")
+	err = ti.Scan(int64(1356124881))
+	maybePanic(err)
+	if !ti.Valid || !ti.Time.Equal(timeValue) {
+		t.Errorf("bad scanned unix time: %v", ti.Time)
+	}
+	err = ti.Scan("0001-01-01 00:00:00")
+	maybePanic(err)
+	assertNullTime(t, ti, "scanned zero time")
+	if err = ti.Scan("soon"); err == nil {
+		t.Error("expected error")
+	}
+}
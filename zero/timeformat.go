@@ -0,0 +1,214 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// TimeFormat is how a LayoutTime is written as JSON and text, and which
+// layouts are accepted when reading it. Layouts are in time.Parse form:
+// Layout is written and read, and Accept lists further layouts that are read.
+type TimeFormat = scalar.TimeFormat
+
+// Sentinel is a time that stands for null in a LayoutTime, for sources that
+// cannot store null. Set it as the Null of a TimeFormat.
+type Sentinel = scalar.Sentinel
+
+const (
+	// GoZero is time.Time{}, "0001-01-01T00:00:00Z", the default.
+	GoZero = scalar.GoZero
+	// UnixEpoch is Unix time 0, "1970-01-01T00:00:00Z",
+	// which some devices send to mean an unknown time.
+	UnixEpoch = scalar.UnixEpoch
+	// MySQLZeroDate is "0000-00-00 00:00:00", the zero DATETIME of legacy MySQL.
+	// It is outside the range of time.Time, so null is written as that string.
+	MySQLZeroDate = scalar.MySQLZeroDate
+)
+
+// TimeLayout names the TimeFormat of a LayoutTime.
+// Implement it on an empty struct type to define your own:
+//
+//	type Compact struct{}
+//
+//	func (Compact) TimeFormat() zero.TimeFormat {
+//		return zero.TimeFormat{Layout: "20060102T150405Z0700"}
+//	}
+//
+//	var t zero.LayoutTime[Compact]
+type TimeLayout interface {
+	TimeFormat() TimeFormat
+}
+
+// RFC3339 is the TimeLayout of Time: RFC 3339 in whole seconds,
+// such as "2012-12-21T21:21:21Z". Fractional seconds are accepted as input.
+type RFC3339 struct{}
+
+// TimeFormat implements TimeLayout.
+func (RFC3339) TimeFormat() TimeFormat { return scalar.RFC3339 }
+
+// RFC3339Nano is RFC 3339 with fractional seconds,
+// such as "2012-12-21T21:21:21.123Z", as time.Time marshals itself.
+type RFC3339Nano struct{}
+
+// TimeFormat implements TimeLayout.
+func (RFC3339Nano) TimeFormat() TimeFormat { return scalar.RFC3339Nano }
+
+// UTC is RFC3339Nano with every time converted to UTC, so the same instant
+// is always written the same way, whatever location a driver returns.
+type UTC struct{}
+
+// TimeFormat implements TimeLayout.
+func (UTC) TimeFormat() TimeFormat { return scalar.UTC }
+
+// MySQL is the layout MySQL writes DATETIME values in, "2006-01-02 15:04:05".
+// Fractional seconds are accepted as input. It has no zone, so times are
+// written on their own wall clock and read in UTC.
+type MySQL struct{}
+
+// TimeFormat implements TimeLayout.
+func (MySQL) TimeFormat() TimeFormat { return scalar.MySQL }
+
+// HTTP is the date layout of HTTP headers, "Mon, 02 Jan 2006 15:04:05 GMT".
+// Times are written in UTC. The obsolete RFC 850 and ANSI C forms are
+// accepted as input, like http.ParseTime.
+type HTTP struct{}
+
+// TimeFormat implements TimeLayout.
+func (HTTP) TimeFormat() TimeFormat { return scalar.HTTP }
+
+// LayoutTime is a nullable time that is written as JSON and text in the
+// format of its TimeLayout L, such as LayoutTime[RFC3339Nano] or
+// LayoutTime[MySQL]. If the format has a Location, times are converted
+// to it when they are scanned, decoded and written.
+// JSON marshals to the Null sentinel of the format if null,
+// by default the zero value for time.Time in that format.
+// Considered to be null to SQL if zero.
+// The embedded Time converts it to and from a Time. SQL and BSON store it
+// like Time, at the Precision of the format.
+type LayoutTime[L TimeLayout] struct {
+	Time
+}
+
+// NewLayoutTime creates a new LayoutTime.
+func NewLayoutTime[L TimeLayout](t time.Time, valid bool) LayoutTime[L] {
+	return LayoutTime[L]{Time: NewTime(t, valid)}
+}
+
+// LayoutTimeFrom creates a new LayoutTime that will
+// be null if t is the zero value or the Null sentinel of its format.
+func LayoutTimeFrom[L TimeLayout](t time.Time) LayoutTime[L] {
+	return NewLayoutTime[L](t, !LayoutTime[L]{}.Format().Null.IsNull(t))
+}
+
+// LayoutTimeFromPtr creates a new LayoutTime that will
+// be null if t is nil or *t is the zero value or the Null sentinel of its format.
+func LayoutTimeFromPtr[L TimeLayout](t *time.Time) LayoutTime[L] {
+	if t == nil {
+		return NewLayoutTime[L](time.Time{}, false)
+	}
+	return LayoutTimeFrom[L](*t)
+}
+
+// Format returns the TimeFormat of L.
+func (t LayoutTime[L]) Format() TimeFormat {
+	var l L
+	return l.TimeFormat()
+}
+
+// Scan implements the Scanner interface, like Time.Scan.
+// Text is read in the layouts of the format first, and text without a zone
+// is read in its Location, as in JSON. int64 values are read as a number of
+// the ScanUnit of the format.
+// The time is converted to the Location of the format, if it has one.
+// The Null sentinel of the format will be considered a null LayoutTime.
+func (t *LayoutTime[L]) Scan(value interface{}) error {
+	err := t.Time.scan(value, reflect.TypeOf(*t), t.Format())
+	t.normalize()
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// The time is passed at the Precision of the format, if it has one.
+func (t LayoutTime[L]) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.Format().Store(t.Time.Time), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string input in any layout the format accepts, and null input.
+// The zero time, the Null sentinel of the format and MySQL zero dates
+// will be considered a null LayoutTime.
+func (t *LayoutTime[L]) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times.
+func (t *LayoutTime[L]) UnmarshalJSONWith(data []byte, p Policy) error {
+	var s string
+	if json.Unmarshal(data, &s) == nil && scalar.IsMySQLZeroDate(s) {
+		t.Valid = false
+		return nil
+	}
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalTimeJSON(data, reflect.TypeOf(*t), p, t.Format())
+	t.normalize()
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null LayoutTime if the input is blank, "null",
+// the zero time, the Null sentinel of the format or a MySQL zero date.
+func (t *LayoutTime[L]) UnmarshalText(text []byte) error {
+	if scalar.IsMySQLZeroDate(string(text)) {
+		t.Valid = false
+		return nil
+	}
+	var err error
+	t.Time.Time, t.Valid, err = scalar.UnmarshalTimeText(text, t.Format())
+	t.normalize()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the Null sentinel of the format, by default the zero value
+// of time.Time, if this LayoutTime is null.
+func (t LayoutTime[L]) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		f := t.Format()
+		return []byte(strconv.Quote(f.Null.Text(f))), nil
+	}
+	return scalar.MarshalTimeJSON(t.Time.Time, t.Format())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the Null sentinel of the format, by default the zero value
+// of time.Time, if this LayoutTime is null.
+func (t LayoutTime[L]) MarshalText() ([]byte, error) {
+	f := t.Format()
+	if t.IsZero() {
+		return []byte(f.Null.Text(f)), nil
+	}
+	return []byte(f.Format(t.Time.Time)), nil
+}
+
+// IsZero returns true for null or zero LayoutTimes, and LayoutTimes equal to
+// the Null sentinel of their format, for potential future omitempty support.
+func (t LayoutTime[L]) IsZero() bool {
+	return !t.Valid || t.Format().Null.IsNull(t.Time.Time)
+}
+
+// normalize converts a decoded time to the Location of the format,
+// and makes the Null sentinel of the format null.
+func (t *LayoutTime[L]) normalize() {
+	f := t.Format()
+	t.Time.Time = f.Normalize(t.Time.Time)
+	t.Valid = t.Valid && !f.Null.IsNull(t.Time.Time)
+}
@@ -0,0 +1,210 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+var layoutRef = time.Date(2012, 12, 21, 21, 21, 21, 123000000, time.UTC)
+
+func TestLayoutTimeJSON(t *testing.T) {
+	data, err := json.Marshal(LayoutTimeFrom[RFC3339Nano](layoutRef))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21.123Z"`, "layout time json marshal")
+	data, err = json.Marshal(LayoutTime[MySQL]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0001-01-01 00:00:00"`, "null layout time json marshal")
+	data, err = json.Marshal(LayoutTime[HTTP]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"Mon, 01 Jan 0001 00:00:00 GMT"`, "null http time json marshal")
+
+	var my LayoutTime[MySQL]
+	err = json.Unmarshal([]byte(`"2012-12-21 21:21:21.123"`), &my)
+	maybePanic(err)
+	if !my.Valid || !my.Time.Time.Equal(layoutRef) {
+		t.Errorf("bad mysql time: %v", my.Time.Time)
+	}
+	err = json.Unmarshal([]byte(`"0001-01-01 00:00:00"`), &my)
+	maybePanic(err)
+	if my.Valid {
+		t.Error("zero time json", "is valid, but should be invalid")
+	}
+	if err = json.Unmarshal([]byte(`"2012-12-21T21:21:21Z"`), &my); err == nil {
+		t.Error("mysql layout should reject RFC 3339 input")
+	}
+}
+
+func TestLayoutTimeText(t *testing.T) {
+	data, err := LayoutTime[MySQL]{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0001-01-01 00:00:00", "null layout time text marshal")
+
+	var unmarshal LayoutTime[MySQL]
+	err = unmarshal.UnmarshalText([]byte("2012-12-21 21:21:21"))
+	maybePanic(err)
+	if !unmarshal.Valid || !unmarshal.Time.Time.Equal(layoutRef.Truncate(time.Second)) {
+		t.Errorf("bad layout time: %v", unmarshal.Time.Time)
+	}
+	err = unmarshal.UnmarshalText([]byte("0001-01-01 00:00:00"))
+	maybePanic(err)
+	if unmarshal.Valid {
+		t.Error("zero time text", "is valid, but should be invalid")
+	}
+}
+
+func TestLayoutTimeLocation(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+	local := time.Date(2012, 12, 21, 22, 21, 21, 0, cet)
+
+	data, err := json.Marshal(LayoutTimeFrom[UTC](local))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21Z"`, "utc json marshal")
+	data, err = json.Marshal(LayoutTime[UTC]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0001-01-01T00:00:00Z"`, "null json marshal")
+
+	var scanned LayoutTime[UTC]
+	err = scanned.Scan(local)
+	maybePanic(err)
+	if scanned.Time.Time.Location() != time.UTC || !scanned.Time.Time.Equal(local) {
+		t.Errorf("scanned time should be normalized to UTC: %v", scanned.Time.Time)
+	}
+
+	var unmarshaled LayoutTime[UTC]
+	err = json.Unmarshal([]byte(`"2012-12-21T22:21:21+01:00"`), &unmarshaled)
+	maybePanic(err)
+	if unmarshaled.Time.Time.Location() != time.UTC || unmarshaled.Time.Time.Hour() != 21 {
+		t.Errorf("unmarshaled time should be normalized to UTC: %v", unmarshaled.Time.Time)
+	}
+}
+
+type microLayout struct{}
+
+func (microLayout) TimeFormat() TimeFormat {
+	f := RFC3339Nano{}.TimeFormat()
+	f.Precision = time.Microsecond
+	return f
+}
+
+func TestLayoutTimePrecision(t *testing.T) {
+	precise := time.Date(2012, 12, 21, 21, 21, 21, 123456789, time.UTC)
+
+	data, err := json.Marshal(LayoutTimeFrom[microLayout](precise))
+	maybePanic(err)
+	assertJSONEquals(t, data, `"2012-12-21T21:21:21.123456Z"`, "microsecond json marshal")
+	v, err := LayoutTimeFrom[microLayout](precise).Value()
+	maybePanic(err)
+	if want := precise.Truncate(time.Microsecond); v != want {
+		t.Errorf("bad value at microsecond precision: %v ≠ %v", v, want)
+	}
+	v, err = LayoutTime[microLayout]{}.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("null layout time should be passed as nil: %v", v)
+	}
+}
+
+type epochLayout struct{}
+
+func (epochLayout) TimeFormat() TimeFormat {
+	f := RFC3339{}.TimeFormat()
+	f.Null = UnixEpoch
+	return f
+}
+
+type mysqlZeroLayout struct{}
+
+func (mysqlZeroLayout) TimeFormat() TimeFormat {
+	f := MySQL{}.TimeFormat()
+	f.Null = MySQLZeroDate
+	return f
+}
+
+func TestLayoutTimeSentinel(t *testing.T) {
+	epoch := time.Unix(0, 0)
+	if LayoutTimeFrom[epochLayout](epoch).Valid {
+		t.Error("epoch should be null with the UnixEpoch sentinel")
+	}
+	if !NewLayoutTime[epochLayout](epoch, true).IsZero() {
+		t.Error("epoch should be zero with the UnixEpoch sentinel")
+	}
+	var et LayoutTime[epochLayout]
+	err := et.Scan(epoch)
+	maybePanic(err)
+	if et.Valid {
+		t.Error("scanned epoch", "is valid, but should be invalid")
+	}
+	for _, in := range []string{`"1970-01-01T00:00:00Z"`, string(zeroTimeJSON)} {
+		et = LayoutTimeFrom[epochLayout](layoutRef)
+		err = json.Unmarshal([]byte(in), &et)
+		maybePanic(err)
+		if et.Valid {
+			t.Error(in, "is valid, but should be invalid")
+		}
+	}
+	data, err := json.Marshal(LayoutTime[epochLayout]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"1970-01-01T00:00:00Z"`, "null json marshal with epoch sentinel")
+	if !LayoutTimeFrom[RFC3339](epoch).Valid {
+		t.Error("epoch should be valid with the GoZero sentinel")
+	}
+
+	data, err = json.Marshal(LayoutTime[mysqlZeroLayout]{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"0000-00-00 00:00:00"`, "null json marshal with mysql sentinel")
+	data, err = LayoutTime[mysqlZeroLayout]{}.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "0000-00-00 00:00:00", "null text marshal with mysql sentinel")
+	var mt LayoutTime[mysqlZeroLayout]
+	err = json.Unmarshal([]byte(`"0000-00-00 00:00:00"`), &mt)
+	maybePanic(err)
+	if mt.Valid {
+		t.Error("mysql zero date layout time", "is valid, but should be invalid")
+	}
+	if !LayoutTimeFrom[mysqlZeroLayout](epoch).Valid {
+		t.Error("epoch should be valid with the MySQLZeroDate sentinel")
+	}
+}
+
+type milliScanLayout struct{}
+
+func (milliScanLayout) TimeFormat() TimeFormat {
+	f := RFC3339Nano{}.TimeFormat()
+	f.ScanUnit = time.Millisecond
+	return f
+}
+
+func TestLayoutTimeScanUnit(t *testing.T) {
+	var millis LayoutTime[milliScanLayout]
+	err := millis.Scan(int64(1356124881123))
+	maybePanic(err)
+	if !millis.Valid || !millis.Time.Time.Equal(timeValue.Add(123*time.Millisecond)) {
+		t.Errorf("bad scanned millis: %v", millis.Time.Time)
+	}
+	err = millis.Scan("0000-00-00 00:00:00")
+	maybePanic(err)
+	if millis.Valid {
+		t.Error("scanned mysql zero date", "is valid, but should be invalid")
+	}
+}
+
+type cetMySQLLayout struct{}
+
+func (cetMySQLLayout) TimeFormat() TimeFormat {
+	f := MySQL{}.TimeFormat()
+	f.Location = time.FixedZone("CET", 3600)
+	return f
+}
+
+func TestLayoutTimeScanLocation(t *testing.T) {
+	const in = "2012-12-21 21:21:21"
+	var scanned, decoded LayoutTime[cetMySQLLayout]
+	err := scanned.Scan(in)
+	maybePanic(err)
+	err = json.Unmarshal([]byte(`"`+in+`"`), &decoded)
+	maybePanic(err)
+	if !scanned.Valid || !scanned.Time.Time.Equal(decoded.Time.Time) {
+		t.Errorf("scanned %v and decoded %v should be the same instant", scanned.Time.Time, decoded.Time.Time)
+	}
+}
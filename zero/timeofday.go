@@ -0,0 +1,134 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// TimeOfDay is a nullable wall clock time without a date or zone,
+// for SQL TIME columns such as opening hours.
+// It marshals to JSON as "15:04:05", with a fraction of a second if it has one.
+// Midnight will be considered null.
+// JSON marshals to "00:00:00" if null.
+// Considered null to SQL if midnight.
+type TimeOfDay struct {
+	TimeOfDay civil.TimeOfDay
+	Valid     bool // Valid is true if TimeOfDay is not NULL
+}
+
+// NewTimeOfDay creates a new TimeOfDay
+func NewTimeOfDay(t civil.TimeOfDay, valid bool) TimeOfDay {
+	return TimeOfDay{
+		TimeOfDay: t,
+		Valid:     valid,
+	}
+}
+
+// TimeOfDayFrom creates a new TimeOfDay that will be null if t is midnight.
+func TimeOfDayFrom(t civil.TimeOfDay) TimeOfDay {
+	return NewTimeOfDay(t, !t.IsZero())
+}
+
+// TimeOfDayFromPtr creates a new TimeOfDay that will be null if t is nil or midnight.
+func TimeOfDayFromPtr(t *civil.TimeOfDay) TimeOfDay {
+	if t == nil {
+		return NewTimeOfDay(civil.TimeOfDay{}, false)
+	}
+	return TimeOfDayFrom(*t)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise midnight.
+func (t TimeOfDay) ValueOrZero() civil.TimeOfDay {
+	if !t.Valid {
+		return civil.TimeOfDay{}
+	}
+	return t.TimeOfDay
+}
+
+// Add returns t plus d, wrapping around midnight. Null and midnight
+// are equivalent, so a null TimeOfDay counts as midnight.
+func (t TimeOfDay) Add(d time.Duration) TimeOfDay {
+	return TimeOfDayFrom(t.ValueOrZero().Add(d))
+}
+
+// Scan implements the Scanner interface.
+// It accepts time.Time values, read on their own wall clock,
+// and "15:04:05" strings and []byte values with an optional fraction.
+// Midnight will be considered a null TimeOfDay.
+func (t *TimeOfDay) Scan(value interface{}) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.ScanTimeOfDay(value, reflect.TypeOf(*t))
+	t.Valid = t.Valid && !t.TimeOfDay.IsZero()
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this TimeOfDay is null or midnight,
+// and a "15:04:05" string otherwise.
+func (t TimeOfDay) Value() (driver.Value, error) {
+	if t.IsZero() {
+		return nil, nil
+	}
+	return t.TimeOfDay.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports "15:04:05" string and null input.
+// Midnight will be considered a null TimeOfDay.
+func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
+	return t.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Only EmptyStringNull applies to times of day.
+func (t *TimeOfDay) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.UnmarshalTimeOfDayJSON(data, reflect.TypeOf(*t), p)
+	t.Valid = t.Valid && !t.TimeOfDay.IsZero()
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null TimeOfDay if the input is blank, midnight, or "null".
+// It will return an error if the input is not a time, blank, or "null".
+func (t *TimeOfDay) UnmarshalText(text []byte) error {
+	var err error
+	t.TimeOfDay, t.Valid, err = scalar.UnmarshalTimeOfDayText(text)
+	t.Valid = t.Valid && !t.TimeOfDay.IsZero()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "00:00:00" if this TimeOfDay is null.
+func (t TimeOfDay) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + t.ValueOrZero().String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode "00:00:00" if this TimeOfDay is null.
+func (t TimeOfDay) MarshalText() ([]byte, error) {
+	return []byte(t.ValueOrZero().String()), nil
+}
+
+// SetValid changes this TimeOfDay's value and also sets it to be non-null.
+func (t *TimeOfDay) SetValid(v civil.TimeOfDay) {
+	t.TimeOfDay = v
+	t.Valid = true
+}
+
+// Ptr returns a pointer to this TimeOfDay's value, or a nil pointer if this TimeOfDay is null.
+func (t TimeOfDay) Ptr() *civil.TimeOfDay {
+	if !t.Valid {
+		return nil
+	}
+	return &t.TimeOfDay
+}
+
+// IsZero returns true for null TimeOfDays or midnight, for future omitempty support.
+func (t TimeOfDay) IsZero() bool {
+	return !t.Valid || t.TimeOfDay.IsZero()
+}
@@ -0,0 +1,40 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/conneqtech/null/civil"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	var tod TimeOfDay
+	err := json.Unmarshal([]byte(`"00:00:00"`), &tod)
+	maybePanic(err)
+	if tod.Valid {
+		t.Error("midnight", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(TimeOfDay{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"00:00:00"`, "null time of day json marshal")
+
+	err = tod.Scan("00:00:00")
+	maybePanic(err)
+	if tod.Valid {
+		t.Error("scanned midnight", "is valid, but should be invalid")
+	}
+	v, err := TimeOfDayFrom(civil.TimeOfDay{}).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("midnight driver value should be nil, not %#v", v)
+	}
+
+	late := TimeOfDayFrom(civil.MustParseTimeOfDay("23:00:00"))
+	if got := late.Add(time.Hour); got.Valid {
+		t.Errorf("wrapping to midnight should be null: %+v", got)
+	}
+	if got := (TimeOfDay{}).Add(time.Hour); !got.Valid || got.TimeOfDay.Hour() != 1 {
+		t.Errorf("null should count as midnight: %+v", got)
+	}
+}
@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Uint16 is a nullable uint16. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint16 struct {
+	Uint16 uint16
+	Valid  bool // Valid is true if Uint16 is not NULL
+}
+
+// NewUint16 creates a new Uint16
+func NewUint16(i uint16, valid bool) Uint16 {
+	return Uint16{
+		Uint16: i,
+		Valid:  valid,
+	}
+}
+
+// Uint16From creates a new Uint16 that will be null if zero.
+func Uint16From(i uint16) Uint16 {
+	return NewUint16(i, i != 0)
+}
+
+// Uint16FromPtr creates a new Uint16 that be null if i is nil.
+func Uint16FromPtr(i *uint16) Uint16 {
+	if i == nil {
+		return NewUint16(0, false)
+	}
+	return NewUint16(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint16) ValueOrZero() uint16 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint16.
+// 0 will be considered a null Uint16.
+func (i *Uint16) Scan(value interface{}) error {
+	return scanNonZero(&i.Uint16, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Uint16 is null or zero.
+func (i Uint16) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Uint16.
+func (i *Uint16) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint16) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Uint16, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint16 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Uint16) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint16) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Uint16, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint16 is null.
+func (i Uint16) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint16 is null.
+func (i Uint16) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Uint16's value and also sets it to be non-null.
+func (i *Uint16) SetValid(n uint16) {
+	i.Uint16 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint16's value, or a nil pointer if this Uint16 is null.
+func (i Uint16) Ptr() *uint16 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Uint16s, for future omitempty support.
+func (i Uint16) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Uint16 as a Value, which implements its methods.
+func (i Uint16) value() Value[uint16] {
+	return NewValue(i.Uint16, i.Valid)
+}
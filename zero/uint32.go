@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Uint32 is a nullable uint32. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint32 struct {
+	Uint32 uint32
+	Valid  bool // Valid is true if Uint32 is not NULL
+}
+
+// NewUint32 creates a new Uint32
+func NewUint32(i uint32, valid bool) Uint32 {
+	return Uint32{
+		Uint32: i,
+		Valid:  valid,
+	}
+}
+
+// Uint32From creates a new Uint32 that will be null if zero.
+func Uint32From(i uint32) Uint32 {
+	return NewUint32(i, i != 0)
+}
+
+// Uint32FromPtr creates a new Uint32 that be null if i is nil.
+func Uint32FromPtr(i *uint32) Uint32 {
+	if i == nil {
+		return NewUint32(0, false)
+	}
+	return NewUint32(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint32) ValueOrZero() uint32 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint32.
+// 0 will be considered a null Uint32.
+func (i *Uint32) Scan(value interface{}) error {
+	return scanNonZero(&i.Uint32, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Uint32 is null or zero.
+func (i Uint32) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Uint32.
+func (i *Uint32) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint32) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Uint32, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint32 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Uint32) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint32) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Uint32, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint32 is null.
+func (i Uint32) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint32 is null.
+func (i Uint32) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Uint32's value and also sets it to be non-null.
+func (i *Uint32) SetValid(n uint32) {
+	i.Uint32 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint32's value, or a nil pointer if this Uint32 is null.
+func (i Uint32) Ptr() *uint32 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Uint32s, for future omitempty support.
+func (i Uint32) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Uint32 as a Value, which implements its methods.
+func (i Uint32) value() Value[uint32] {
+	return NewValue(i.Uint32, i.Valid)
+}
@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Uint64 is a nullable uint64. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint64 struct {
+	Uint64 uint64
+	Valid  bool // Valid is true if Uint64 is not NULL
+}
+
+// NewUint64 creates a new Uint64
+func NewUint64(i uint64, valid bool) Uint64 {
+	return Uint64{
+		Uint64: i,
+		Valid:  valid,
+	}
+}
+
+// Uint64From creates a new Uint64 that will be null if zero.
+func Uint64From(i uint64) Uint64 {
+	return NewUint64(i, i != 0)
+}
+
+// Uint64FromPtr creates a new Uint64 that be null if i is nil.
+func Uint64FromPtr(i *uint64) Uint64 {
+	if i == nil {
+		return NewUint64(0, false)
+	}
+	return NewUint64(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint64) ValueOrZero() uint64 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint64.
+// 0 will be considered a null Uint64.
+func (i *Uint64) Scan(value interface{}) error {
+	return scanNonZero(&i.Uint64, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Uint64 is null or zero.
+func (i Uint64) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Uint64.
+func (i *Uint64) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint64) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Uint64, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint64 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Uint64) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint64) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Uint64, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint64 is null.
+func (i Uint64) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint64 is null.
+func (i Uint64) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Uint64's value and also sets it to be non-null.
+func (i *Uint64) SetValid(n uint64) {
+	i.Uint64 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint64's value, or a nil pointer if this Uint64 is null.
+func (i Uint64) Ptr() *uint64 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Uint64s, for future omitempty support.
+func (i Uint64) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Uint64 as a Value, which implements its methods.
+func (i Uint64) value() Value[uint64] {
+	return NewValue(i.Uint64, i.Valid)
+}
@@ -0,0 +1,47 @@
+package zero
+
+import "github.com/conneqtech/null/internal/scalar"
+
+// Uint64String is a nullable uint64 that is encoded as a JSON string,
+// such as "9007199254740993", so that JavaScript clients can read values
+// above 2^53 without losing precision. It accepts both numbers and strings
+// as input, like the ",string" struct tag option of encoding/json.
+// Zero input will be considered null. Everything else, including SQL
+// and text, behaves like Uint64.
+type Uint64String struct {
+	Uint64
+}
+
+// NewUint64String creates a new Uint64String
+func NewUint64String(i uint64, valid bool) Uint64String {
+	return Uint64String{Uint64: NewUint64(i, valid)}
+}
+
+// Uint64StringFrom creates a new Uint64String that will be null if i is zero.
+func Uint64StringFrom(i uint64) Uint64String {
+	return Uint64String{Uint64: Uint64From(i)}
+}
+
+// Uint64StringFromPtr creates a new Uint64String that will be null if i is nil.
+func Uint64StringFromPtr(i *uint64) Uint64String {
+	return Uint64String{Uint64: Uint64FromPtr(i)}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+func (i *Uint64String) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+// Numeric strings are always accepted.
+func (i *Uint64String) UnmarshalJSONWith(data []byte, p Policy) error {
+	p.NumberStrings = true
+	return i.Uint64.UnmarshalJSONWith(data, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode "0" if this Uint64String is null.
+func (i Uint64String) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + scalar.Format(i.ValueOrZero()) + `"`), nil
+}
@@ -0,0 +1,112 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Uint8 is a nullable uint8. Zero input will be considered null.
+// JSON marshals to zero if null.
+// Considered null to SQL if zero.
+type Uint8 struct {
+	Uint8 uint8
+	Valid bool // Valid is true if Uint8 is not NULL
+}
+
+// NewUint8 creates a new Uint8
+func NewUint8(i uint8, valid bool) Uint8 {
+	return Uint8{
+		Uint8: i,
+		Valid: valid,
+	}
+}
+
+// Uint8From creates a new Uint8 that will be null if zero.
+func Uint8From(i uint8) Uint8 {
+	return NewUint8(i, i != 0)
+}
+
+// Uint8FromPtr creates a new Uint8 that be null if i is nil.
+func Uint8FromPtr(i *uint8) Uint8 {
+	if i == nil {
+		return NewUint8(0, false)
+	}
+	return NewUint8(*i, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (i Uint8) ValueOrZero() uint8 {
+	return i.value().ValueOrZero()
+}
+
+// Scan implements the Scanner interface.
+// It returns an error if the scanned value is out of range for a uint8.
+// 0 will be considered a null Uint8.
+func (i *Uint8) Scan(value interface{}) error {
+	return scanNonZero(&i.Uint8, &i.Valid, value, reflect.TypeOf(*i))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Uint8 is null or zero.
+func (i Uint8) Value() (driver.Value, error) {
+	return i.value().Value()
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Uint8.
+func (i *Uint8) UnmarshalJSON(data []byte) error {
+	return i.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (i *Uint8) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&i.Uint8, &i.Valid, data, reflect.TypeOf(*i), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Uint8 if the input is blank, zero, or "null".
+// It will return an error if the input is not a integer, blank, or "null".
+func (i *Uint8) UnmarshalText(text []byte) error {
+	return i.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (i *Uint8) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&i.Uint8, &i.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode 0 if this Uint8 is null.
+func (i Uint8) MarshalJSON() ([]byte, error) {
+	return i.value().MarshalJSON()
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode a zero if this Uint8 is null.
+func (i Uint8) MarshalText() ([]byte, error) {
+	return i.value().MarshalText()
+}
+
+// SetValid changes this Uint8's value and also sets it to be non-null.
+func (i *Uint8) SetValid(n uint8) {
+	i.Uint8 = n
+	i.Valid = true
+}
+
+// Ptr returns a pointer to this Uint8's value, or a nil pointer if this Uint8 is null.
+func (i Uint8) Ptr() *uint8 {
+	return i.value().Ptr()
+}
+
+// IsZero returns true for null or zero Uint8s, for future omitempty support.
+func (i Uint8) IsZero() bool {
+	return i.value().IsZero()
+}
+
+// value returns this Uint8 as a Value, which implements its methods.
+func (i Uint8) value() Value[uint8] {
+	return NewValue(i.Uint8, i.Valid)
+}
@@ -0,0 +1,122 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+	"github.com/conneqtech/null/uuid"
+)
+
+// UUID is a nullable uuid.UUID. The nil UUID will be considered null.
+// JSON marshals to the nil UUID if null.
+// Considered null to SQL if the nil UUID.
+type UUID struct {
+	UUID  uuid.UUID
+	Valid bool // Valid is true if UUID is not NULL
+}
+
+// NewUUID creates a new UUID
+func NewUUID(u uuid.UUID, valid bool) UUID {
+	return UUID{
+		UUID:  u,
+		Valid: valid,
+	}
+}
+
+// UUIDFrom creates a new UUID that will be null if u is the nil UUID.
+func UUIDFrom(u uuid.UUID) UUID {
+	return NewUUID(u, !u.IsNil())
+}
+
+// UUIDFromPtr creates a new UUID that will be null if u is nil.
+func UUIDFromPtr(u *uuid.UUID) UUID {
+	if u == nil {
+		return NewUUID(uuid.Nil, false)
+	}
+	return NewUUID(*u, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise the nil UUID.
+func (u UUID) ValueOrZero() uuid.UUID {
+	if !u.Valid {
+		return uuid.Nil
+	}
+	return u.UUID
+}
+
+// Scan implements the Scanner interface.
+// It accepts the text forms of a UUID as a string or []byte,
+// and its 16 raw bytes as a []byte.
+// The nil UUID will be considered a null UUID.
+func (u *UUID) Scan(value interface{}) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.ScanUUID(value, reflect.TypeOf(*u))
+	u.Valid = u.Valid && !u.UUID.IsNil()
+	return err
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this UUID is null or the nil UUID,
+// and the canonical text form otherwise.
+func (u UUID) Value() (driver.Value, error) {
+	if u.IsZero() {
+		return nil, nil
+	}
+	return u.UUID.String(), nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports string and null input, and every form accepted by uuid.Parse.
+// The nil UUID will be considered a null UUID.
+func (u *UUID) UnmarshalJSON(data []byte) error {
+	return u.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (u *UUID) UnmarshalJSONWith(data []byte, p Policy) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.UnmarshalUUIDJSON(data, reflect.TypeOf(*u), p)
+	u.Valid = u.Valid && !u.UUID.IsNil()
+	return err
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null UUID if the input is blank, the nil UUID, or "null".
+func (u *UUID) UnmarshalText(text []byte) error {
+	var err error
+	u.UUID, u.Valid, err = scalar.UnmarshalUUIDText(text)
+	u.Valid = u.Valid && !u.UUID.IsNil()
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the nil UUID if this UUID is null.
+func (u UUID) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + u.ValueOrZero().String() + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the nil UUID if this UUID is null.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.ValueOrZero().String()), nil
+}
+
+// SetValid changes this UUID's value and also sets it to be non-null.
+func (u *UUID) SetValid(v uuid.UUID) {
+	u.UUID = v
+	u.Valid = true
+}
+
+// Ptr returns a pointer to this UUID's value, or a nil pointer if this UUID is null.
+func (u UUID) Ptr() *uuid.UUID {
+	if !u.Valid {
+		return nil
+	}
+	return &u.UUID
+}
+
+// IsZero returns true for null or nil UUIDs, for future omitempty support.
+func (u UUID) IsZero() bool {
+	return !u.Valid || u.UUID.IsNil()
+}
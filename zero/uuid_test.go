@@ -0,0 +1,33 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/conneqtech/null/uuid"
+)
+
+func TestUUID(t *testing.T) {
+	var u UUID
+	err := json.Unmarshal([]byte(`"00000000-0000-0000-0000-000000000000"`), &u)
+	maybePanic(err)
+	if u.Valid {
+		t.Error("nil uuid json", "is valid, but should be invalid")
+	}
+	data, err := json.Marshal(UUID{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `"00000000-0000-0000-0000-000000000000"`, "null uuid json marshal")
+
+	want := uuid.MustParse("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	err = u.Scan("f47ac10b-58cc-4372-a567-0e02b2c3d479")
+	maybePanic(err)
+	if u.UUID != want || !u.Valid {
+		t.Errorf("bad uuid scan: %v", u.UUID)
+	}
+
+	v, err := UUIDFrom(uuid.Nil).Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("nil uuid driver value should be nil, not %#v", v)
+	}
+}
@@ -0,0 +1,150 @@
+package zero
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/conneqtech/null/internal/scalar"
+)
+
+// Scalar is the set of types that Value can hold:
+// every integer, unsigned integer and float width, bool and string,
+// including named types derived from them.
+type Scalar interface {
+	scalar.Scalar
+}
+
+// Value is a nullable scalar of any width. Zero input will be considered null.
+// JSON marshals to the zero value of T if null.
+// Considered null to SQL if zero.
+type Value[T Scalar] struct {
+	V     T
+	Valid bool // Valid is true if V is not NULL
+}
+
+// NewValue creates a new Value.
+func NewValue[T Scalar](v T, valid bool) Value[T] {
+	return Value[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// ValueFrom creates a new Value that will be null if v is zero.
+func ValueFrom[T Scalar](v T) Value[T] {
+	return NewValue(v, !scalar.IsZero(v))
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil.
+// Like the other FromPtr constructors, a pointer to zero is valid.
+func ValueFromPtr[T Scalar](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// ValueOrZero returns the inner value if valid, otherwise zero.
+func (v Value[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.V
+}
+
+// Scan implements the Scanner interface.
+// A zero value scans as null.
+func (v *Value[T]) Scan(src interface{}) error {
+	return scanNonZero(&v.V, &v.Valid, src, reflect.TypeOf(*v))
+}
+
+// Value implements the driver Valuer interface.
+// It will return nil if this Value is null or zero.
+func (v Value[T]) Value() (driver.Value, error) {
+	if v.IsZero() {
+		return nil, nil
+	}
+	return scalar.DriverValue(v.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// It supports number, string and null input.
+// 0 will be considered a null Value.
+// It also supports unmarshalling a sql.NullInt64 style object.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	return v.UnmarshalJSONWith(data, scalar.Default)
+}
+
+// UnmarshalJSONWith is like UnmarshalJSON, but accepts the input that p allows.
+func (v *Value[T]) UnmarshalJSONWith(data []byte, p Policy) error {
+	return unmarshalJSONNonZero(&v.V, &v.Valid, data, reflect.TypeOf(*v), p)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// It will unmarshal to a null Value if the input is blank, zero, or "null".
+func (v *Value[T]) UnmarshalText(text []byte) error {
+	return v.UnmarshalTextWith(text, scalar.Default)
+}
+
+// UnmarshalTextWith is like UnmarshalText, but accepts the input that p allows.
+func (v *Value[T]) UnmarshalTextWith(text []byte, p Policy) error {
+	return unmarshalTextNonZero(&v.V, &v.Valid, text, p)
+}
+
+// MarshalJSON implements json.Marshaler.
+// It will encode the zero value of T if this Value is null.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	return scalar.MarshalJSON(v.ValueOrZero())
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// It will encode the zero value of T if this Value is null.
+func (v Value[T]) MarshalText() ([]byte, error) {
+	return []byte(scalar.Format(v.ValueOrZero())), nil
+}
+
+// SetValid changes this Value's value and also sets it to be non-null.
+func (v *Value[T]) SetValid(n T) {
+	v.V = n
+	v.Valid = true
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (v Value[T]) Ptr() *T {
+	if !v.Valid {
+		return nil
+	}
+	return &v.V
+}
+
+// IsZero returns true for null or zero Values, for future omitempty support.
+func (v Value[T]) IsZero() bool {
+	return !v.Valid || scalar.IsZero(v.V)
+}
+
+// scanNonZero scans src into v and valid for Value and the fixed-width types,
+// so that they all treat zero as null the same way.
+func scanNonZero[T Scalar](v *T, valid *bool, src interface{}, typ reflect.Type) error {
+	var err error
+	*v, *valid, err = scalar.Scan[T](src, typ)
+	*valid = *valid && !scalar.IsZero(*v)
+	return err
+}
+
+// unmarshalJSONNonZero is the JSON counterpart of scanNonZero.
+func unmarshalJSONNonZero[T Scalar](v *T, valid *bool, data []byte, typ reflect.Type, p Policy) error {
+	var err error
+	*v, *valid, err = scalar.UnmarshalJSONPolicy[T](data, typ, p)
+	*valid = *valid && !scalar.IsZero(*v)
+	return err
+}
+
+// unmarshalTextNonZero is the text counterpart of scanNonZero.
+func unmarshalTextNonZero[T Scalar](v *T, valid *bool, text []byte, p Policy) error {
+	var err error
+	*v, *valid, err = scalar.UnmarshalTextPolicy[T](text, p)
+	*valid = *valid && !scalar.IsZero(*v)
+	return err
+}
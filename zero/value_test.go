@@ -0,0 +1,124 @@
+package zero
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValueFrom(t *testing.T) {
+	v := ValueFrom[int8](-12)
+	if v.V != -12 || !v.Valid {
+		t.Errorf("ValueFrom(-12) = %+v", v)
+	}
+
+	zero := ValueFrom[uint16](0)
+	if zero.Valid {
+		t.Error("ValueFrom(0)", "is valid, but should be invalid")
+	}
+
+	var n float32
+	if p := ValueFromPtr(&n); !p.Valid || p.V != 0 {
+		t.Errorf("ValueFromPtr(&0) = %+v, want a valid zero like Int8FromPtr", p)
+	}
+	if ValueFromPtr[float32](nil).Valid {
+		t.Error("ValueFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestUnmarshalValue(t *testing.T) {
+	var i Value[int16]
+	err := json.Unmarshal([]byte(`-12345`), &i)
+	maybePanic(err)
+	if i.V != -12345 || !i.Valid {
+		t.Errorf("bad int16 json: %+v", i)
+	}
+
+	var zero Value[int16]
+	err = json.Unmarshal(zeroJSON, &zero)
+	maybePanic(err)
+	if zero.Valid {
+		t.Error("zero json", "is valid, but should be invalid")
+	}
+
+	var overflow Value[uint8]
+	if err = json.Unmarshal([]byte(`256`), &overflow); err == nil {
+		t.Error("expected error for uint8 overflow")
+	}
+}
+
+func TestMarshalValue(t *testing.T) {
+	tests := []struct {
+		v    json.Marshaler
+		want string
+	}{
+		{ValueFrom[int8](-12), "-12"},
+		{Value[int32]{}, "0"},
+		{Value[float32]{}, "0"},
+		{Value[bool]{}, "false"},
+		{Value[string]{}, `""`},
+	}
+	for _, tc := range tests {
+		data, err := tc.v.MarshalJSON()
+		maybePanic(err)
+		assertJSONEquals(t, data, tc.want, "value json marshal")
+	}
+}
+
+func TestValueScan(t *testing.T) {
+	var i Value[int8]
+	err := i.Scan(int64(0))
+	maybePanic(err)
+	if i.Valid {
+		t.Error("scanned zero", "is valid, but should be invalid")
+	}
+
+	if err = i.Scan(int64(-129)); err == nil {
+		t.Error("expected error for int8 overflow")
+	}
+
+	val, err := NewValue[uint8](0, true).Value()
+	maybePanic(err)
+	if val != nil {
+		t.Errorf("zero driver value should be nil, not %#v", val)
+	}
+}
+
+func TestSizedTypes(t *testing.T) {
+	if Int8From(0).Valid {
+		t.Error("Int8From(0)", "is valid, but should be invalid")
+	}
+
+	var u16 Uint16
+	err := json.Unmarshal([]byte(`"0"`), &u16)
+	maybePanic(err)
+	if u16.Valid {
+		t.Error("zero string json", "is valid, but should be invalid")
+	}
+
+	data, err := json.Marshal(Float32{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "0", "null float32 json marshal")
+
+	txt, err := NewInt32(0, false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, txt, "0", "null int32 text marshal")
+
+	var i16 Int16
+	if err = i16.Scan(int64(1 << 15)); err == nil {
+		t.Error("expected error for int16 overflow")
+	}
+	err = i16.Scan(int64(-3))
+	maybePanic(err)
+	if i16.ValueOrZero() != -3 {
+		t.Error("unexpected ValueOrZero", i16.ValueOrZero())
+	}
+
+	val, err := Uint64From(0).Value()
+	maybePanic(err)
+	if val != nil {
+		t.Errorf("zero driver value should be nil, not %#v", val)
+	}
+	if Uint8From(0).ValueOrZero() != 0 || Uint32From(7).ValueOrZero() != 7 {
+		t.Error("unexpected ValueOrZero")
+	}
+}